@@ -99,6 +99,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
@@ -305,6 +306,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		glue.ServicePackage,
 		grafana.ServicePackage,
 		greengrass.ServicePackage,
+		greengrassv2.ServicePackage,
 		guardduty.ServicePackage,
 		healthlake.ServicePackage,
 		iam.ServicePackage,
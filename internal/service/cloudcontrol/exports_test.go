@@ -0,0 +1,17 @@
+package cloudcontrol
+
+// Exports for use in tests only.
+var (
+	CreationToken                       = creationToken
+	DesiredStateWithDrift               = desiredStateWithDrift
+	FindPendingProgressEvent            = findPendingProgressEvent
+	FindResourceWhenNewResourceNotFound = findResourceWhenNewResourceNotFound
+	ProgressEventFailureDiagnostics     = progressEventFailureDiagnostics
+	ProgressEventHookFailure            = progressEventHookFailure
+	ReadUnsupported                     = readUnsupported
+	ReadUnsupportedDiagnostics          = readUnsupportedDiagnostics
+	ResourceCreateResourceID            = resourceCreateResourceID
+	ResourceParseResourceID             = resourceParseResourceID
+	ResumeOrStartRequest                = resumeOrStartRequest
+	WaitProgressEventOperationStatus    = waitProgressEventOperationStatus
+)
@@ -2,15 +2,22 @@ package cloudcontrol
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -21,8 +28,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/mattbaird/jsonpatch"
 )
 
@@ -41,14 +52,43 @@ func ResourceResource() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"auto_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"creation_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"desired_state": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"properties": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"read_only_properties": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"retain_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -59,6 +99,12 @@ func ResourceResource() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"type_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -74,76 +120,267 @@ func ResourceResource() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceResourceCustomizeDiffGetSchema,
 			resourceResourceCustomizeDiffSchemaDiff,
+			resourceResourceCustomizeDiffTags,
 			customdiff.ComputedIf("properties", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("desired_state")
 			}),
+			customdiff.ComputedIf("read_only_properties", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("desired_state")
+			}),
+			customdiff.ComputedIf("outputs", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("desired_state")
+			}),
+			customdiff.ComputedIf("status_message", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges("auto_tags", "desired_state", "tags_all")
+			}),
 		),
 	}
 }
 
 func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudControlClient()
+	region := d.Get("region").(string)
+	optFns := regionOptFns(region)
 
 	typeName := d.Get("type_name").(string)
+	desiredState, err := desiredStateWithAutoTags(d, d.Get("desired_state").(string), d.Get("tags_all"))
+
+	if err != nil {
+		return diag.Errorf("creating Cloud Control API (%s) Resource: %s", typeName, err)
+	}
+
+	roleARN := d.Get("role_arn").(string)
+	typeVersionID := d.Get("type_version_id").(string)
 	input := &cloudcontrol.CreateResourceInput{
-		ClientToken:  aws.String(id.UniqueId()),
-		DesiredState: aws.String(d.Get("desired_state").(string)),
+		// A client token derived from the configuration means that if the create is interrupted before
+		// the resource is recorded in state, retrying it resumes the original request instead of creating a duplicate.
+		ClientToken:  aws.String(nextCreationToken(typeName, typeVersionID, roleARN, desiredState)),
+		DesiredState: aws.String(desiredState),
 		TypeName:     aws.String(typeName),
 	}
 
-	if v, ok := d.GetOk("role_arn"); ok {
-		input.RoleArn = aws.String(v.(string))
+	if roleARN != "" {
+		input.RoleArn = aws.String(roleARN)
 	}
 
-	if v, ok := d.GetOk("type_version_id"); ok {
-		input.TypeVersionId = aws.String(v.(string))
+	if typeVersionID != "" {
+		input.TypeVersionId = aws.String(typeVersionID)
 	}
 
-	output, err := conn.CreateResource(ctx, input)
+	var diags diag.Diagnostics
+	var output *cloudcontrol.CreateResourceOutput
 
-	if err != nil {
-		return diag.Errorf("creating Cloud Control API (%s) Resource: %s", typeName, err)
+	for {
+		output, err = conn.CreateResource(ctx, input, optFns...)
+
+		if err != nil {
+			return diag.Errorf("creating Cloud Control API (%s) Resource: %s", typeName, err)
+		}
+
+		// Within the client token's idempotency window, a request with the same client token returns the original request's
+		// progress. If that request failed, or the resource it created has since been deleted (e.g. it is being replaced),
+		// create the resource again with the next client token for the configuration.
+		if reused, err := creationTokenReused(ctx, conn, output.ProgressEvent, typeName, typeVersionID, roleARN, optFns...); err != nil {
+			return diag.Errorf("creating Cloud Control API (%s) Resource: %s", typeName, err)
+		} else if !reused {
+			break
+		}
+
+		log.Printf("[DEBUG] Cloud Control API (%s) Resource client token (%s) already used, retrying with the next token", typeName, aws.ToString(input.ClientToken))
+		input.ClientToken = aws.String(nextCreationToken(typeName, typeVersionID, roleARN, desiredState))
+	}
+
+	d.Set("creation_token", input.ClientToken)
+
+	// A completed request with the same client token created the resource in an earlier run, which was interrupted
+	// before the resource was recorded in state, unless the resource belongs to another resource with an identical configuration.
+	if output.ProgressEvent.OperationStatus == types.OperationStatusSuccess {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Cloud Control API Resource created by an earlier request",
+			Detail: fmt.Sprintf("An earlier request (%s) with the same configuration created Cloud Control API (%s) Resource (%s), which is now managed by this resource. "+
+				"If the resource is already managed by another resource with an identical configuration, remove this resource from state with `terraform state rm` and apply again.",
+				aws.ToString(output.ProgressEvent.RequestToken), typeName, aws.ToString(output.ProgressEvent.Identifier)),
+		})
 	}
 
 	// Always try to capture the identifier before returning errors.
-	d.SetId(aws.ToString(output.ProgressEvent.Identifier))
+	// It is recorded as soon as it is assigned, so that a create that is interrupted (e.g. by the timeout being reached or
+	// Terraform being stopped) is recorded in state and its pending request is waited for by the next operation.
+	identifier := newProgressEventIdentifier(output.ProgressEvent)
+
+	output.ProgressEvent, err = waitProgressEventOperationStatus(ctx, identifier.refresh(statusProgressEventOperation(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), optFns...)), d.Timeout(schema.TimeoutCreate))
 
-	output.ProgressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutCreate))
+	if v := identifier.get(); v != "" {
+		d.SetId(resourceCreateResourceID(v, region))
+	}
 
 	if err != nil {
-		return diag.Errorf("waiting for Cloud Control API (%s) Resource (%s) create: %s", typeName, d.Id(), err)
+		return append(diags, progressEventFailureDiagnostics(output.ProgressEvent, err, typeName, d.Id(), "create")...)
 	}
 
 	// Some resources do not set the identifier until after creation.
 	if d.Id() == "" {
-		d.SetId(aws.ToString(output.ProgressEvent.Identifier))
+		d.SetId(resourceCreateResourceID(aws.ToString(output.ProgressEvent.Identifier), region))
 	}
 
-	return resourceResourceRead(ctx, d, meta)
+	d.Set("status_message", output.ProgressEvent.StatusMessage)
+
+	return append(diags, resourceResourceRead(ctx, d, meta)...)
+}
+
+// creationTokenCounts counts the CreateResource client tokens issued for each configuration by the provider,
+// which runs for a single Terraform operation.
+var creationTokenCounts = struct {
+	sync.Mutex
+	counts map[string]int
+}{
+	counts: make(map[string]int),
+}
+
+// nextCreationToken returns the next CreateResource client token for the configuration in this Terraform operation.
+// The resource's address isn't available to the provider, so resources with identical configurations (e.g. with count or for_each)
+// are told apart by the order in which they are created, and each gets its own client token.
+func nextCreationToken(typeName, typeVersionID, roleARN, desiredState string) string {
+	key := creationToken(typeName, typeVersionID, roleARN, desiredState, 0)
+
+	creationTokenCounts.Lock()
+	n := creationTokenCounts.counts[key]
+	creationTokenCounts.counts[key]++
+	creationTokenCounts.Unlock()
+
+	return creationToken(typeName, typeVersionID, roleARN, desiredState, n)
+}
+
+// creationToken returns the `n`th CreateResource client token derived from the resource's configuration.
+func creationToken(typeName, typeVersionID, roleARN, desiredState string, n int) string {
+	h := sha256.New()
+
+	for _, v := range []string{typeName, typeVersionID, roleARN, desiredState, strconv.Itoa(n)} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// creationTokenReused returns whether a CreateResource progress event is that of an earlier request with the same client token
+// whose result can't be used: either the request has failed or the resource it created no longer exists.
+func creationTokenReused(ctx context.Context, conn *cloudcontrol.Client, progressEvent *types.ProgressEvent, typeName, typeVersionID, roleARN string, optFns ...func(*cloudcontrol.Options)) (bool, error) {
+	switch progressEvent.OperationStatus {
+	case types.OperationStatusFailed, types.OperationStatusCancelComplete:
+		return true, nil
+	case types.OperationStatusSuccess:
+		_, err := FindResource(ctx, conn, aws.ToString(progressEvent.Identifier), typeName, typeVersionID, roleARN, optFns...)
+
+		if tfresource.NotFound(err) {
+			return true, nil
+		}
+
+		// The resource can't be checked, and adopting it could make this resource manage one created for another configuration.
+		if readUnsupported(err) {
+			return false, fmt.Errorf("an earlier request with the same client token created resource (%s), but the resource type doesn't support the read action so it can't be verified. If the resource belongs to this configuration, import it with `terraform import`", aws.ToString(progressEvent.Identifier))
+		}
+
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return false, nil
 }
 
 func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudControlClient()
 
+	identifier, region := resourceParseResourceID(d.Id())
 	typeName := d.Get("type_name").(string)
-	resourceDescription, err := FindResource(ctx, conn,
-		d.Id(),
-		typeName,
-		d.Get("type_version_id").(string),
-		d.Get("role_arn").(string),
-	)
+	resourceDescription, err := findResourceWhenNewResourceNotFound(ctx, d.Timeout(schema.TimeoutCreate), d.IsNewResource(), func() (*types.ResourceDescription, error) {
+		return FindResource(ctx, conn,
+			identifier,
+			typeName,
+			d.Get("type_version_id").(string),
+			d.Get("role_arn").(string),
+			regionOptFns(region)...,
+		)
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// A create interrupted before it completed is kept in state until its request completes.
+		if progressEvent, err := findPendingProgressEvent(ctx, conn, typeName, identifier, regionOptFns(region)...); err == nil && progressEvent.Operation == types.OperationCreate {
+			log.Printf("[WARN] Cloud Control API Resource (%s) not found, create request (%s) in progress", d.Id(), aws.ToString(progressEvent.RequestToken))
+			return nil
+		}
+
 		log.Printf("[WARN] Cloud Control API Resource (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	// Keep the last known state rather than failing the refresh.
+	if readUnsupported(err) {
+		return readUnsupportedDiagnostics(diag.Warning, typeName, identifier, err)
+	}
+
 	if err != nil {
 		return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 	}
 
 	d.Set("properties", resourceDescription.Properties)
+	d.Set("region", region)
+
+	properties := aws.ToString(resourceDescription.Properties)
+
+	if d.Get("auto_tags").(bool) {
+		property, err := tagsPropertyFromSchema(d.Get("schema").(string))
+
+		if err != nil {
+			return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+		}
+
+		var tags map[string]string
+		tags, properties, err = managedTags(d.Get("desired_state").(string), properties, property)
+
+		if err != nil {
+			return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+		}
+
+		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+		allTags := tftags.New(ctx, tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+		//lintignore:AWSR002
+		if err := d.Set("tags", allTags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+			return diag.Errorf("setting tags: %s", err)
+		}
+
+		if err := d.Set("tags_all", allTags.Map()); err != nil {
+			return diag.Errorf("setting tags_all: %s", err)
+		}
+	} else {
+		d.Set("tags", nil)
+		d.Set("tags_all", nil)
+	}
+
+	readOnlyProperties, outputs, err := readOnlyPropertiesAndOutputs(aws.ToString(resourceDescription.Properties), d.Get("schema").(string))
+
+	if err != nil {
+		return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+	}
+
+	d.Set("read_only_properties", readOnlyProperties)
+	d.Set("outputs", outputs)
+
+	// Reflect any out-of-band changes to managed properties so that the next plan proposes restoring them.
+	if !d.IsNewResource() {
+		desiredState, err := desiredStateWithDrift(d.Get("desired_state").(string), properties, d.Get("schema").(string))
+
+		if err != nil {
+			return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+		}
+
+		d.Set("desired_state", desiredState)
+	}
 
 	return nil
 }
@@ -151,19 +388,34 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudControlClient()
 
-	if d.HasChange("desired_state") {
+	if d.HasChanges("auto_tags", "desired_state", "tags_all") {
+		identifier, region := resourceParseResourceID(d.Id())
+		optFns := regionOptFns(region)
+		typeName := d.Get("type_name").(string)
 		oldRaw, newRaw := d.GetChange("desired_state")
+		oldTagsAllRaw, newTagsAllRaw := d.GetChange("tags_all")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		// tags_all is only ever set in state when auto_tags is enabled.
+		oldDesiredState, err := desiredStateWithAutoTags(d, oldRaw.(string), oldTagsAllRaw)
 
 		if err != nil {
-			return diag.Errorf("creating JSON Patch: %s", err)
+			return diag.Errorf("updating Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 		}
 
-		typeName := d.Get("type_name").(string)
+		newDesiredState, err := desiredStateWithAutoTags(d, newRaw.(string), newTagsAllRaw)
+
+		if err != nil {
+			return diag.Errorf("updating Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+		}
+
+		patchDocument, err := patchDocument(oldDesiredState, newDesiredState)
+
+		if err != nil {
+			return diag.Errorf("creating JSON Patch: %s", err)
+		}
 		input := &cloudcontrol.UpdateResourceInput{
 			ClientToken:   aws.String(id.UniqueId()),
-			Identifier:    aws.String(d.Id()),
+			Identifier:    aws.String(identifier),
 			PatchDocument: aws.String(patchDocument),
 			TypeName:      aws.String(typeName),
 		}
@@ -176,15 +428,34 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.TypeVersionId = aws.String(v.(string))
 		}
 
-		output, err := conn.UpdateResource(ctx, input)
+		pendingRequestToken, err := findPendingRequestToken(ctx, conn, typeName, identifier, optFns...)
+
+		if err != nil {
+			return diag.Errorf("updating Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+		}
+
+		// The patch is computed from the desired state in state, so a pending update is waited for but never resumed.
+		progressEvent, err := resumeOrStartRequest(ctx, "", pendingRequestToken, d.Timeout(schema.TimeoutUpdate), findProgressEventFunc(conn, optFns...), func(ctx context.Context) (*types.ProgressEvent, error) {
+			output, err := conn.UpdateResource(ctx, input, optFns...)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return output.ProgressEvent, nil
+		})
 
 		if err != nil {
 			return diag.Errorf("updating Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 		}
 
-		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return diag.Errorf("waiting for Cloud Control API (%s) Resource (%s) update: %s", typeName, d.Id(), err)
+		progressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(progressEvent.RequestToken), d.Timeout(schema.TimeoutUpdate), optFns...)
+
+		if err != nil {
+			return progressEventFailureDiagnostics(progressEvent, err, typeName, d.Id(), "update")
 		}
+
+		d.Set("status_message", progressEvent.StatusMessage)
 	}
 
 	return resourceResourceRead(ctx, d, meta)
@@ -193,10 +464,23 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudControlClient()
 
+	identifier, region := resourceParseResourceID(d.Id())
+	optFns := regionOptFns(region)
 	typeName := d.Get("type_name").(string)
+
+	// Equivalent to a CloudFormation DeletionPolicy of Retain.
+	if d.Get("retain_on_delete").(bool) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Cloud Control API Resource retained",
+				Detail:   fmt.Sprintf("retain_on_delete is set, so Cloud Control API (%s) Resource (%s) has been removed from Terraform state but not deleted.", typeName, identifier),
+			},
+		}
+	}
 	input := &cloudcontrol.DeleteResourceInput{
 		ClientToken: aws.String(id.UniqueId()),
-		Identifier:  aws.String(d.Id()),
+		Identifier:  aws.String(identifier),
 		TypeName:    aws.String(typeName),
 	}
 
@@ -208,21 +492,35 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		input.TypeVersionId = aws.String(v.(string))
 	}
 
+	pendingRequestToken, err := findPendingRequestToken(ctx, conn, typeName, identifier, optFns...)
+
+	if err != nil {
+		return diag.Errorf("deleting Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+	}
+
 	log.Printf("[INFO] Deleting Cloud Control API (%s) Resource: %s", typeName, d.Id())
-	output, err := conn.DeleteResource(ctx, input)
+	progressEvent, err := resumeOrStartRequest(ctx, types.OperationDelete, pendingRequestToken, d.Timeout(schema.TimeoutDelete), findProgressEventFunc(conn, optFns...), func(ctx context.Context) (*types.ProgressEvent, error) {
+		output, err := conn.DeleteResource(ctx, input, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.ProgressEvent, nil
+	})
 
 	if err != nil {
 		return diag.Errorf("deleting Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 	}
 
-	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutDelete))
+	progressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(progressEvent.RequestToken), d.Timeout(schema.TimeoutDelete), optFns...)
 
 	if progressEvent != nil && progressEvent.ErrorCode == types.HandlerErrorCodeNotFound {
 		return nil
 	}
 
 	if err != nil {
-		return diag.Errorf("waiting for Cloud Control API (%s) Resource (%s) delete: %s", typeName, d.Id(), err)
+		return progressEventFailureDiagnostics(progressEvent, err, typeName, d.Id(), "delete")
 	}
 
 	return nil
@@ -237,6 +535,17 @@ func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.Re
 		return nil
 	}
 
+	// Private resource types are registered per region, so read the schema from the resource's region.
+	if region := diff.Get("region").(string); region != "" {
+		session, err := conns.NewSessionForRegion(&conn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+
+		if err != nil {
+			return fmt.Errorf("creating AWS session (%s): %w", region, err)
+		}
+
+		conn = cloudformation.New(session)
+	}
+
 	typeName := diff.Get("type_name").(string)
 
 	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
@@ -313,7 +622,111 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	return nil
 }
 
-func FindResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
+func resourceResourceCustomizeDiffTags(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("auto_tags").(bool) {
+		if v, ok := diff.Get("tags").(map[string]interface{}); ok && len(v) > 0 {
+			return errors.New(`"tags" can only be set when "auto_tags" is true`)
+		}
+
+		return nil
+	}
+
+	// The schema can be unknown if the type is not yet registered.
+	if !diff.NewValueKnown("schema") {
+		return nil
+	}
+
+	property, err := tagsPropertyFromSchema(diff.Get("schema").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if property == nil {
+		return fmt.Errorf("auto_tags: Cloud Control API resource type (%s) does not declare a supported tags property", diff.Get("type_name").(string))
+	}
+
+	return verify.SetTagsDiff(ctx, diff, meta)
+}
+
+// desiredStateWithAutoTags returns `desiredState` with the tags in `tagsAll` merged in if auto_tags is enabled.
+func desiredStateWithAutoTags(d *schema.ResourceData, desiredState string, tagsAll interface{}) (string, error) {
+	if !d.Get("auto_tags").(bool) {
+		return desiredState, nil
+	}
+
+	property, err := tagsPropertyFromSchema(d.Get("schema").(string))
+
+	if err != nil {
+		return "", err
+	}
+
+	return desiredStateWithTags(desiredState, property, flex.ExpandStringValueMap(tagsAll.(map[string]interface{})))
+}
+
+// readUnsupported returns whether `err` is the result of reading a resource whose type doesn't support the read handler.
+func readUnsupported(err error) bool {
+	return errs.IsA[*types.UnsupportedActionException](err)
+}
+
+// readUnsupportedDiagnostics returns a diagnostic explaining that resources of type `typeName` can't be read.
+func readUnsupportedDiagnostics(severity diag.Severity, typeName, identifier string, err error) diag.Diagnostics {
+	detail := fmt.Sprintf("Cloud Control API (%s) Resource (%s) can't be read as the resource type doesn't support the read action: %s", typeName, identifier, err)
+
+	if severity == diag.Warning {
+		detail += "\n\nThe last known state is kept. Changes made outside of Terraform won't be detected."
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("reading Cloud Control API (%s) Resource (%s): read not supported", typeName, identifier),
+			Detail:   detail,
+		},
+	}
+}
+
+const resourceIDSeparator = ","
+
+// resourceCreateResourceID returns the resource's ID. If the resource is managed in a region other than
+// the provider's, the region is appended to the Cloud Control API identifier so that the resource can be read
+// from the correct regional endpoint.
+func resourceCreateResourceID(identifier, region string) string {
+	if region == "" {
+		return identifier
+	}
+
+	return identifier + resourceIDSeparator + region
+}
+
+// resourceParseResourceID returns the Cloud Control API identifier and region encoded in the resource's ID.
+// The region is empty if the ID has no region suffix.
+func resourceParseResourceID(id string) (string, string) {
+	if i := strings.LastIndex(id, resourceIDSeparator); i != -1 {
+		identifier, region := id[:i], id[i+1:]
+
+		if _, es := verify.ValidRegionName(region, "region"); identifier != "" && region != "" && len(es) == 0 {
+			return identifier, region
+		}
+	}
+
+	return id, ""
+}
+
+// regionOptFns returns Cloud Control API client options that send requests to `region`, if set.
+func regionOptFns(region string) []func(*cloudcontrol.Options) {
+	if region == "" {
+		return nil
+	}
+
+	return []func(*cloudcontrol.Options){
+		func(o *cloudcontrol.Options) {
+			o.Region = region
+		},
+	}
+}
+
+func FindResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string, optFns ...func(*cloudcontrol.Options)) (*types.ResourceDescription, error) {
 	input := &cloudcontrol.GetResourceInput{
 		Identifier: aws.String(resourceID),
 		TypeName:   aws.String(typeName),
@@ -325,7 +738,7 @@ func FindResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, ty
 		input.TypeVersionId = aws.String(typeVersionID)
 	}
 
-	output, err := conn.GetResource(ctx, input)
+	output, err := conn.GetResource(ctx, input, optFns...)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
@@ -354,12 +767,27 @@ func FindResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, ty
 	return output.ResourceDescription, nil
 }
 
-func findProgressEventByRequestToken(ctx context.Context, conn *cloudcontrol.Client, requestToken string) (*types.ProgressEvent, error) {
+// findResourceWhenNewResourceNotFound calls `find`, retrying while a newly created resource is not found.
+// Cloud Control API is eventually consistent, so a read immediately after a successful create may not yet find the resource.
+// Retries are bounded by `timeout`, after which the resource is genuinely not found.
+func findResourceWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, isNewResource bool, find func() (*types.ResourceDescription, error)) (*types.ResourceDescription, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, timeout, func() (interface{}, error) {
+		return find()
+	}, isNewResource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*types.ResourceDescription), nil
+}
+
+func findProgressEventByRequestToken(ctx context.Context, conn *cloudcontrol.Client, requestToken string, optFns ...func(*cloudcontrol.Options)) (*types.ProgressEvent, error) {
 	input := &cloudcontrol.GetResourceRequestStatusInput{
 		RequestToken: aws.String(requestToken),
 	}
 
-	output, err := conn.GetResourceRequestStatus(ctx, input)
+	output, err := conn.GetResourceRequestStatus(ctx, input, optFns...)
 
 	if errs.IsA[*types.RequestTokenNotFoundException](err) {
 		return nil, &retry.NotFoundError{
@@ -379,9 +807,20 @@ func findProgressEventByRequestToken(ctx context.Context, conn *cloudcontrol.Cli
 	return output.ProgressEvent, nil
 }
 
-func statusProgressEventOperation(ctx context.Context, conn *cloudcontrol.Client, requestToken string) retry.StateRefreshFunc {
+// findProgressEventFunc returns a function that finds the progress event of a request by its request token.
+func findProgressEventFunc(conn *cloudcontrol.Client, optFns ...func(*cloudcontrol.Options)) func(context.Context, string) (*types.ProgressEvent, error) {
+	return func(ctx context.Context, requestToken string) (*types.ProgressEvent, error) {
+		return findProgressEventByRequestToken(ctx, conn, requestToken, optFns...)
+	}
+}
+
+func statusProgressEventOperation(ctx context.Context, conn *cloudcontrol.Client, requestToken string, optFns ...func(*cloudcontrol.Options)) retry.StateRefreshFunc {
+	return statusProgressEvent(ctx, findProgressEventFunc(conn, optFns...), requestToken)
+}
+
+func statusProgressEvent(ctx context.Context, find func(context.Context, string) (*types.ProgressEvent, error), requestToken string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := findProgressEventByRequestToken(ctx, conn, requestToken)
+		output, err := find(ctx, requestToken)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -391,15 +830,46 @@ func statusProgressEventOperation(ctx context.Context, conn *cloudcontrol.Client
 			return nil, "", err
 		}
 
+		logProgressEvent(output)
+
 		return output, string(output.OperationStatus), nil
 	}
 }
 
-func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcontrol.Client, requestToken string, timeout time.Duration) (*types.ProgressEvent, error) {
+// logProgressEvent logs a polled progress event, giving visibility into the progress of long-running operations.
+func logProgressEvent(event *types.ProgressEvent) {
+	var eventTime, retryAfter string
+
+	if v := event.EventTime; v != nil {
+		eventTime = v.Format(time.RFC3339)
+	}
+
+	if v := event.RetryAfter; v != nil {
+		retryAfter = v.Format(time.RFC3339)
+	}
+
+	log.Printf("[DEBUG] Cloud Control API (%s) %s request (%s) %s at %s: %q (retry after: %s)",
+		aws.ToString(event.TypeName),
+		event.Operation,
+		aws.ToString(event.RequestToken),
+		event.OperationStatus,
+		eventTime,
+		aws.ToString(event.StatusMessage),
+		retryAfter,
+	)
+}
+
+func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcontrol.Client, requestToken string, timeout time.Duration, optFns ...func(*cloudcontrol.Options)) (*types.ProgressEvent, error) {
+	return waitProgressEventOperationStatus(ctx, statusProgressEventOperation(ctx, conn, requestToken, optFns...), timeout)
+}
+
+// waitProgressEventOperationStatus polls `refresh` until the operation leaves the pending states.
+// The only limit on how long a slow operation is waited for is `timeout`, which comes from the resource's timeouts block.
+func waitProgressEventOperationStatus(ctx context.Context, refresh retry.StateRefreshFunc, timeout time.Duration) (*types.ProgressEvent, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.OperationStatusInProgress, types.OperationStatusPending),
 		Target:  enum.Slice(types.OperationStatusSuccess),
-		Refresh: statusProgressEventOperation(ctx, conn, requestToken),
+		Refresh: refresh,
 		Timeout: timeout,
 	}
 
@@ -407,7 +877,11 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 
 	if output, ok := outputRaw.(*types.ProgressEvent); ok {
 		if output.OperationStatus == types.OperationStatusFailed {
-			tfresource.SetLastError(err, fmt.Errorf("%s: %s", output.ErrorCode, aws.ToString(output.StatusMessage)))
+			if v := progressEventHookFailure(output); v != nil {
+				tfresource.SetLastError(err, v)
+			} else {
+				tfresource.SetLastError(err, fmt.Errorf("%s: %s", output.ErrorCode, aws.ToString(output.StatusMessage)))
+			}
 		}
 
 		return output, err
@@ -416,6 +890,603 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 	return nil, err
 }
 
+var (
+	hookFailureStatusMessageRegexp = regexp.MustCompile(`(?i)(\bhook\(s\)|\bhooks?\b).*\bfail|\bfail.*(\bhook\(s\)|\bhooks?\b)`)
+	hookFailureModeRegexp          = regexp.MustCompile(`(?i)\bfailure ?mode\b\W*(FAIL|WARN)\b`)
+	hookTypeNameRegexp             = regexp.MustCompile(`\b[A-Za-z0-9]+::[A-Za-z0-9]+::[A-Za-z0-9]+\b`)
+)
+
+// hookFailureError is the failure of an operation that was rejected by CloudFormation Hooks,
+// such as the proactive controls of AWS Control Tower, rather than by the resource type's handler.
+type hookFailureError struct {
+	ErrorCode     types.HandlerErrorCode
+	FailureMode   string
+	StatusMessage string
+	TypeNames     []string
+}
+
+func (e *hookFailureError) Error() string {
+	typeNames := "unknown"
+
+	if len(e.TypeNames) > 0 {
+		typeNames = strings.Join(e.TypeNames, ", ")
+	}
+
+	return fmt.Sprintf("rejected by CloudFormation Hook (%s) with failure mode %s: %s", typeNames, e.FailureMode, e.StatusMessage)
+}
+
+// progressEventHookFailure returns the hook failure of a failed operation, or nil if the operation
+// failed for another reason. Cloud Control API reports hook failures with the handler error codes,
+// so they are identified by the status message, which names the hooks' type names.
+func progressEventHookFailure(event *types.ProgressEvent) *hookFailureError {
+	if event == nil || event.OperationStatus != types.OperationStatusFailed {
+		return nil
+	}
+
+	statusMessage := aws.ToString(event.StatusMessage)
+
+	if !hookFailureStatusMessageRegexp.MatchString(statusMessage) {
+		return nil
+	}
+
+	// Only hooks in the FAIL failure mode stop an operation; hooks in the WARN mode only log their result.
+	failureMode := "FAIL"
+
+	if m := hookFailureModeRegexp.FindStringSubmatch(statusMessage); m != nil {
+		failureMode = strings.ToUpper(m[1])
+	}
+
+	var typeNames []string
+	seen := make(map[string]bool)
+
+	for _, v := range hookTypeNameRegexp.FindAllString(statusMessage, -1) {
+		// The status message may also name the resource's own type.
+		if v == aws.ToString(event.TypeName) || seen[v] {
+			continue
+		}
+
+		seen[v] = true
+		typeNames = append(typeNames, v)
+	}
+
+	return &hookFailureError{
+		ErrorCode:     event.ErrorCode,
+		FailureMode:   failureMode,
+		StatusMessage: statusMessage,
+		TypeNames:     typeNames,
+	}
+}
+
+// progressEventFailureDiagnostics returns the diagnostics for an operation whose progress event could not be waited for successfully.
+// Operations rejected by CloudFormation Hooks get a dedicated diagnostic, as the resource's handler did not fail.
+func progressEventFailureDiagnostics(event *types.ProgressEvent, err error, typeName, id, operation string) diag.Diagnostics {
+	if v := progressEventHookFailure(event); v != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cloud Control API (%s) Resource (%s) %s rejected by CloudFormation Hook", typeName, id, operation),
+				Detail:   fmt.Sprintf("The %s was %s.\n\nThe resource's handler did not fail (error code: %s). Review the hook's results or contact the hook's owner.", operation, v, v.ErrorCode),
+			},
+		}
+	}
+
+	return diag.Errorf("waiting for Cloud Control API (%s) Resource (%s) %s: %s", typeName, id, operation, err)
+}
+
+// findPendingProgressEvent returns the progress event of a request for the resource identified by `typeName` and `identifier`
+// that is still in progress. Cloud Control API keeps track of the requests made for a resource, so a request left in progress
+// by an interrupted operation is found even if the operation didn't get to record anything in state.
+func findPendingProgressEvent(ctx context.Context, conn cloudcontrol.ListResourceRequestsAPIClient, typeName, identifier string, optFns ...func(*cloudcontrol.Options)) (*types.ProgressEvent, error) {
+	input := &cloudcontrol.ListResourceRequestsInput{
+		ResourceRequestStatusFilter: &types.ResourceRequestStatusFilter{
+			OperationStatuses: []types.OperationStatus{
+				types.OperationStatusCancelInProgress,
+				types.OperationStatusInProgress,
+				types.OperationStatusPending,
+			},
+		},
+	}
+
+	pages := cloudcontrol.NewListResourceRequestsPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceRequestStatusSummaries {
+			if aws.ToString(v.TypeName) == typeName && aws.ToString(v.Identifier) == identifier {
+				v := v
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// findPendingRequestToken returns the request token of a request for the resource that is still in progress, or "" if there is none.
+func findPendingRequestToken(ctx context.Context, conn cloudcontrol.ListResourceRequestsAPIClient, typeName, identifier string, optFns ...func(*cloudcontrol.Options)) (string, error) {
+	progressEvent, err := findPendingProgressEvent(ctx, conn, typeName, identifier, optFns...)
+
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("listing pending requests: %w", err)
+	}
+
+	return aws.ToString(progressEvent.RequestToken), nil
+}
+
+// progressEventIdentifier records the resource identifier of the progress events returned by a refresh function
+// as soon as it is assigned. It is safe for concurrent use, as the refresh function runs in its own goroutine.
+type progressEventIdentifier struct {
+	mu         sync.Mutex
+	identifier string
+}
+
+func newProgressEventIdentifier(progressEvent *types.ProgressEvent) *progressEventIdentifier {
+	return &progressEventIdentifier{
+		identifier: aws.ToString(progressEvent.Identifier),
+	}
+}
+
+func (p *progressEventIdentifier) get() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.identifier
+}
+
+func (p *progressEventIdentifier) refresh(f retry.StateRefreshFunc) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		outputRaw, status, err := f()
+
+		if output, ok := outputRaw.(*types.ProgressEvent); ok {
+			if v := aws.ToString(output.Identifier); v != "" {
+				p.mu.Lock()
+				p.identifier = v
+				p.mu.Unlock()
+			}
+		}
+
+		return outputRaw, status, err
+	}
+}
+
+// resumeOrStartRequest returns the progress event of the request to wait for to complete `operation`.
+// If the pending request recorded in state by an interrupted operation is for the same `operation` and hasn't failed, it is resumed.
+// A pending request for another operation, or one that can't be resumed (an empty `operation`), is waited for before `start` is called
+// to start a new request, as Cloud Control API rejects concurrent requests for a resource. Expired pending requests are ignored.
+func resumeOrStartRequest(ctx context.Context, operation types.Operation, pendingRequestToken string, timeout time.Duration, find func(context.Context, string) (*types.ProgressEvent, error), start func(context.Context) (*types.ProgressEvent, error)) (*types.ProgressEvent, error) {
+	if pendingRequestToken == "" {
+		return start(ctx)
+	}
+
+	progressEvent, err := find(ctx, pendingRequestToken)
+
+	if tfresource.NotFound(err) {
+		return start(ctx)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading pending request (%s): %w", pendingRequestToken, err)
+	}
+
+	switch progressEvent.OperationStatus {
+	case types.OperationStatusPending, types.OperationStatusInProgress, types.OperationStatusSuccess:
+		if operation != "" && progressEvent.Operation == operation {
+			log.Printf("[INFO] Resuming Cloud Control API %s request (%s)", operation, pendingRequestToken)
+
+			return progressEvent, nil
+		}
+	}
+
+	switch progressEvent.OperationStatus {
+	case types.OperationStatusPending, types.OperationStatusInProgress, types.OperationStatusCancelInProgress:
+		log.Printf("[INFO] Waiting for pending Cloud Control API %s request (%s)", progressEvent.Operation, pendingRequestToken)
+
+		stateConf := &retry.StateChangeConf{
+			Pending: enum.Slice(types.OperationStatusInProgress, types.OperationStatusPending, types.OperationStatusCancelInProgress),
+			Target:  enum.Slice(types.OperationStatusSuccess, types.OperationStatusFailed, types.OperationStatusCancelComplete),
+			Refresh: statusProgressEvent(ctx, find, pendingRequestToken),
+			Timeout: timeout,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil && !tfresource.NotFound(err) {
+			return nil, fmt.Errorf("waiting for pending %s request (%s): %w", progressEvent.Operation, pendingRequestToken, err)
+		}
+	}
+
+	return start(ctx)
+}
+
+// desiredStateWithDrift returns `desiredState` with the value of each property that differs
+// from the resource's current `properties` replaced by the current value.
+// Only properties present in `desiredState` are compared; see desiredValueWithDrift.
+// Top-level read-only and write-only properties, as well as properties not returned by GetResource, are not compared.
+// Properties that AWS adds with default values are therefore ignored, while create-only properties present in `desiredState`
+// are compared like any other so that their drift proposes replacing the resource.
+// If no property has drifted, `desiredState` is returned unchanged.
+func desiredStateWithDrift(desiredState, properties, resourceSchema string) (string, error) {
+	if desiredState == "" || properties == "" {
+		return desiredState, nil
+	}
+
+	var desired, current map[string]interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", fmt.Errorf("decoding desired_state JSON: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(properties), &current); err != nil {
+		return "", fmt.Errorf("decoding properties JSON: %w", err)
+	}
+
+	var cfResource *cfschema.Resource
+
+	if resourceSchema != "" {
+		var err error
+
+		cfResource, err = resourceSchemaResource(resourceSchema)
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var drifted bool
+
+	for name, desiredValue := range desired {
+		path := "/" + name
+
+		if cfResource != nil && (isReadOnlyPropertyPath(cfResource, path) || isWriteOnlyPropertyPath(cfResource, path)) {
+			continue
+		}
+
+		currentValue, ok := current[name]
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := desiredValueWithDrift(desiredValue, currentValue); ok {
+			desired[name] = v
+			drifted = true
+		}
+	}
+
+	if !drifted {
+		return desiredState, nil
+	}
+
+	b, err := json.Marshal(desired)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding desired_state JSON: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// desiredValueWithDrift returns `desired` with any value that differs from `current` replaced by the current value,
+// and whether any value differed.
+// Objects are compared recursively on the keys present in `desired` only, so that values added by AWS are ignored.
+// Arrays are compared irrespective of element order and replaced as a whole if they differ, keeping only the object keys
+// present in `desired`; see currentValueWithDesiredKeys.
+// Equivalent scalars, such as 14 and "14", are considered equal.
+func desiredValueWithDrift(desired, current interface{}) (interface{}, bool) {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		currentMap, ok := current.(map[string]interface{})
+
+		if !ok {
+			return current, true
+		}
+
+		var drifted bool
+		result := make(map[string]interface{}, len(desired))
+
+		for k, desiredValue := range desired {
+			result[k] = desiredValue
+
+			currentValue, ok := currentMap[k]
+
+			if !ok {
+				continue
+			}
+
+			if v, ok := desiredValueWithDrift(desiredValue, currentValue); ok {
+				result[k] = v
+				drifted = true
+			}
+		}
+
+		return result, drifted
+	case []interface{}:
+		if current, ok := current.([]interface{}); ok && equivalentArrays(desired, current) {
+			return desired, false
+		}
+
+		return currentValueWithDesiredKeys(desired, current), true
+	default:
+		if equivalentScalars(desired, current) {
+			return desired, false
+		}
+
+		return current, true
+	}
+}
+
+// currentValueWithDesiredKeys returns `current` without the object keys that are absent from `desired`, such as
+// the default values added by AWS, so that replacing a drifted value doesn't also propose removing them.
+// The elements of an array are matched against the keys of all the objects in the desired array.
+// Values whose type differs from `desired` are returned unchanged.
+func currentValueWithDesiredKeys(desired, current interface{}) interface{} {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		currentMap, ok := current.(map[string]interface{})
+
+		if !ok {
+			return current
+		}
+
+		result := make(map[string]interface{})
+
+		for k, currentValue := range currentMap {
+			if desiredValue, ok := desired[k]; ok {
+				result[k] = currentValueWithDesiredKeys(desiredValue, currentValue)
+			}
+		}
+
+		return result
+	case []interface{}:
+		currentArray, ok := current.([]interface{})
+
+		if !ok {
+			return current
+		}
+
+		// Merge the desired objects into one holding every desired key.
+		var element map[string]interface{}
+
+		for _, v := range desired {
+			if v, ok := v.(map[string]interface{}); ok {
+				if element == nil {
+					element = make(map[string]interface{})
+				}
+
+				for k, v := range v {
+					if _, ok := element[k]; !ok {
+						element[k] = v
+					}
+				}
+			}
+		}
+
+		if element == nil {
+			return current
+		}
+
+		result := make([]interface{}, len(currentArray))
+
+		for i, v := range currentArray {
+			result[i] = currentValueWithDesiredKeys(element, v)
+		}
+
+		return result
+	default:
+		return current
+	}
+}
+
+// equivalentArrays returns whether each element of `desired` is equivalent to a distinct element of `current`.
+func equivalentArrays(desired, current []interface{}) bool {
+	if len(desired) != len(current) {
+		return false
+	}
+
+	matched := make([]bool, len(current))
+
+	for _, desiredValue := range desired {
+		var found bool
+
+		for i, currentValue := range current {
+			if matched[i] {
+				continue
+			}
+
+			if _, drifted := desiredValueWithDrift(desiredValue, currentValue); !drifted {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equivalentScalars returns whether two decoded JSON scalar values have the same string representation.
+func equivalentScalars(desired, current interface{}) bool {
+	if desired == nil || current == nil {
+		return desired == nil && current == nil
+	}
+
+	desiredString, ok := scalarString(desired)
+
+	if !ok {
+		return false
+	}
+
+	currentString, ok := scalarString(current)
+
+	if !ok {
+		return false
+	}
+
+	return desiredString == currentString
+}
+
+func scalarString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// resourceSchemaResource parses a CloudFormation resource type schema.
+func resourceSchemaResource(resourceSchema string) (*cfschema.Resource, error) {
+	resourceSchema, err := cfschema.Sanitize(resourceSchema)
+
+	if err != nil {
+		return nil, fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(resourceSchema)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResource, err := cfResourceSchema.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return cfResource, nil
+}
+
+func isReadOnlyPropertyPath(cfResource *cfschema.Resource, path string) bool {
+	for _, readOnlyProperty := range cfResource.ReadOnlyProperties {
+		if readOnlyProperty.EqualsStringPath(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWriteOnlyPropertyPath(cfResource *cfschema.Resource, path string) bool {
+	for _, writeOnlyProperty := range cfResource.WriteOnlyProperties {
+		if writeOnlyProperty.EqualsStringPath(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readOnlyPropertiesAndOutputs returns a JSON document containing only the read-only properties,
+// as declared by the resource type schema, of the resource's current `properties`,
+// along with a map of the top-level read-only properties that have scalar values.
+func readOnlyPropertiesAndOutputs(properties, resourceSchema string) (string, map[string]string, error) {
+	if properties == "" || resourceSchema == "" {
+		return "", nil, nil
+	}
+
+	var current map[string]interface{}
+
+	if err := json.Unmarshal([]byte(properties), &current); err != nil {
+		return "", nil, fmt.Errorf("decoding properties JSON: %w", err)
+	}
+
+	cfResource, err := resourceSchemaResource(resourceSchema)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	readOnly := make(map[string]interface{})
+	outputs := make(map[string]string)
+
+	for _, readOnlyProperty := range cfResource.ReadOnlyProperties {
+		path := strings.Split(strings.TrimPrefix(string(readOnlyProperty), "/properties/"), "/")
+
+		value, ok := propertyValueAtPath(current, path)
+
+		if !ok {
+			continue
+		}
+
+		setPropertyValueAtPath(readOnly, path, value)
+
+		if len(path) != 1 {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			outputs[path[0]] = v
+		case float64:
+			outputs[path[0]] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			outputs[path[0]] = strconv.FormatBool(v)
+		}
+	}
+
+	b, err := json.Marshal(readOnly)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding read-only properties JSON: %w", err)
+	}
+
+	return string(b), outputs, nil
+}
+
+// propertyValueAtPath returns the value at the specified path of nested objects.
+func propertyValueAtPath(m map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = m
+
+	for _, name := range path {
+		object, ok := value.(map[string]interface{})
+
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = object[name]
+
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// setPropertyValueAtPath sets the value at the specified path of nested objects, creating intermediate objects as required.
+func setPropertyValueAtPath(m map[string]interface{}, path []string, value interface{}) {
+	for _, name := range path[:len(path)-1] {
+		object, ok := m[name].(map[string]interface{})
+
+		if !ok {
+			object = make(map[string]interface{})
+			m[name] = object
+		}
+
+		m = object
+	}
+
+	m[path[len(path)-1]] = value
+}
+
 // patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
 func patchDocument(old, new string) (string, error) {
 	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
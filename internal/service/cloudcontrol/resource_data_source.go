@@ -54,6 +54,10 @@ func dataSourceResourceRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Get("role_arn").(string),
 	)
 
+	if readUnsupported(err) {
+		return readUnsupportedDiagnostics(diag.Error, typeName, identifier, err)
+	}
+
 	if err != nil {
 		return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, identifier, err)
 	}
@@ -2,19 +2,30 @@ package cloudcontrol_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -44,12 +55,1043 @@ func TestAccCloudControlResource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`^\{.*\}$`)),
 					resource.TestMatchResourceAttr(resourceName, "schema", regexp.MustCompile(`^\{.*`)),
+					resource.TestMatchResourceAttr(resourceName, "read_only_properties", regexp.MustCompile(`^\{"Arn":".*"\}$`)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "outputs.Arn", "logs", regexp.MustCompile(fmt.Sprintf(`log-group:%s:\*$`, rName))),
 				),
 			},
 		},
 	})
 }
 
+func TestWaitProgressEventOperationStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Statuses    []types.OperationStatus
+		Timeout     time.Duration
+		ExpectError bool
+	}{
+		{
+			Name:     "immediate success",
+			Statuses: []types.OperationStatus{types.OperationStatusSuccess},
+			Timeout:  5 * time.Second,
+		},
+		{
+			Name: "slow success within timeout",
+			Statuses: []types.OperationStatus{
+				types.OperationStatusPending,
+				types.OperationStatusInProgress,
+				types.OperationStatusInProgress,
+				types.OperationStatusInProgress,
+				types.OperationStatusInProgress,
+				types.OperationStatusSuccess,
+			},
+			Timeout: 1 * time.Minute,
+		},
+		{
+			Name:        "failed",
+			Statuses:    []types.OperationStatus{types.OperationStatusInProgress, types.OperationStatusFailed},
+			Timeout:     5 * time.Second,
+			ExpectError: true,
+		},
+		{
+			Name:        "timeout",
+			Statuses:    []types.OperationStatus{types.OperationStatusInProgress},
+			Timeout:     1 * time.Second,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			refresh := func() (interface{}, string, error) {
+				// The last status is repeated once the progression is exhausted.
+				status := testCase.Statuses[len(testCase.Statuses)-1]
+				if calls < len(testCase.Statuses) {
+					status = testCase.Statuses[calls]
+				}
+				calls++
+
+				return &types.ProgressEvent{
+					OperationStatus: status,
+					StatusMessage:   aws.String(fmt.Sprintf("status %d", calls)),
+				}, string(status), nil
+			}
+
+			output, err := tfcloudcontrol.WaitProgressEventOperationStatus(ctx, refresh, testCase.Timeout)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := output.OperationStatus, types.OperationStatusSuccess; got != want {
+				t.Errorf("OperationStatus = %s, want %s", got, want)
+			}
+
+			if got, want := calls, len(testCase.Statuses); got != want {
+				t.Errorf("polled %d times, want %d", got, want)
+			}
+
+			// The final event's status message is the one captured in state.
+			if got, want := aws.StringValue(output.StatusMessage), fmt.Sprintf("status %d", len(testCase.Statuses)); got != want {
+				t.Errorf("StatusMessage = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestFindResourceWhenNewResourceNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	notFound := &retry.NotFoundError{LastError: &types.ResourceNotFoundException{}}
+
+	testCases := []struct {
+		Name          string
+		NotFoundCalls int
+		Err           error
+		IsNewResource bool
+		Timeout       time.Duration
+		ExpectedCalls int
+		ExpectError   func(error) bool
+	}{
+		{
+			Name:          "found",
+			IsNewResource: true,
+			Timeout:       5 * time.Second,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "transient not found",
+			NotFoundCalls: 2,
+			IsNewResource: true,
+			Timeout:       1 * time.Minute,
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "not found after timeout",
+			NotFoundCalls: -1,
+			IsNewResource: true,
+			Timeout:       2 * time.Second,
+			ExpectError:   tfresource.NotFound,
+		},
+		{
+			Name:          "not found existing resource",
+			NotFoundCalls: -1,
+			Timeout:       1 * time.Minute,
+			ExpectedCalls: 1,
+			ExpectError:   tfresource.NotFound,
+		},
+		{
+			Name:          "other error",
+			Err:           &types.HandlerFailureException{},
+			IsNewResource: true,
+			Timeout:       1 * time.Minute,
+			ExpectedCalls: 1,
+			ExpectError:   func(err error) bool { return errs.IsA[*types.HandlerFailureException](err) },
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			find := func() (*types.ResourceDescription, error) {
+				calls++
+
+				if testCase.NotFoundCalls < 0 || calls <= testCase.NotFoundCalls {
+					return nil, notFound
+				}
+
+				if testCase.Err != nil {
+					return nil, testCase.Err
+				}
+
+				return &types.ResourceDescription{Identifier: aws.String("example")}, nil
+			}
+
+			output, err := tfcloudcontrol.FindResourceWhenNewResourceNotFound(ctx, testCase.Timeout, testCase.IsNewResource, find)
+
+			if testCase.ExpectError != nil {
+				if !testCase.ExpectError(err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, want := aws.StringValue(output.Identifier), "example"; got != want {
+					t.Errorf("Identifier = %s, want %s", got, want)
+				}
+			}
+
+			// A resource that is never found is polled until the timeout expires.
+			if testCase.ExpectedCalls > 0 {
+				if got, want := calls, testCase.ExpectedCalls; got != want {
+					t.Errorf("found %d times, want %d", got, want)
+				}
+			} else if calls < 2 {
+				t.Errorf("found %d times, want retries", calls)
+			}
+		})
+	}
+}
+
+func TestResumeOrStartRequest(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	const (
+		pendingRequestToken = "pending"
+		newRequestToken     = "new"
+	)
+
+	testCases := []struct {
+		Name                string
+		Operation           types.Operation
+		PendingRequestToken string
+		PendingOperation    types.Operation
+		PendingStatuses     []types.OperationStatus
+		PendingNotFound     bool
+		FindError           error
+		ExpectedToken       string
+		ExpectError         bool
+	}{
+		{
+			Name:          "no pending request",
+			Operation:     types.OperationDelete,
+			ExpectedToken: newRequestToken,
+		},
+		{
+			Name:                "delete resumed after interruption",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			PendingOperation:    types.OperationDelete,
+			PendingStatuses:     []types.OperationStatus{types.OperationStatusInProgress},
+			ExpectedToken:       pendingRequestToken,
+		},
+		{
+			Name:                "delete completed after interruption",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			PendingOperation:    types.OperationDelete,
+			PendingStatuses:     []types.OperationStatus{types.OperationStatusSuccess},
+			ExpectedToken:       pendingRequestToken,
+		},
+		{
+			Name:                "delete failed after interruption",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			PendingOperation:    types.OperationDelete,
+			PendingStatuses:     []types.OperationStatus{types.OperationStatusFailed},
+			ExpectedToken:       newRequestToken,
+		},
+		{
+			Name:                "delete after create interrupted",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			PendingOperation:    types.OperationCreate,
+			PendingStatuses:     []types.OperationStatus{types.OperationStatusInProgress, types.OperationStatusInProgress, types.OperationStatusSuccess},
+			ExpectedToken:       newRequestToken,
+		},
+		{
+			Name:                "delete after create interrupted and failed",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			PendingOperation:    types.OperationCreate,
+			PendingStatuses:     []types.OperationStatus{types.OperationStatusInProgress, types.OperationStatusFailed},
+			ExpectedToken:       newRequestToken,
+		},
+		{
+			Name:                "update after update interrupted",
+			PendingRequestToken: pendingRequestToken,
+			PendingOperation:    types.OperationUpdate,
+			PendingStatuses:     []types.OperationStatus{types.OperationStatusInProgress, types.OperationStatusSuccess},
+			ExpectedToken:       newRequestToken,
+		},
+		{
+			Name:                "pending request expired",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			PendingNotFound:     true,
+			ExpectedToken:       newRequestToken,
+		},
+		{
+			Name:                "pending request error",
+			Operation:           types.OperationDelete,
+			PendingRequestToken: pendingRequestToken,
+			FindError:           errors.New("test"),
+			ExpectError:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls, starts int
+			find := func(ctx context.Context, requestToken string) (*types.ProgressEvent, error) {
+				if requestToken != testCase.PendingRequestToken {
+					t.Errorf("found request %s, want %s", requestToken, testCase.PendingRequestToken)
+				}
+
+				if testCase.FindError != nil {
+					return nil, testCase.FindError
+				}
+
+				if testCase.PendingNotFound {
+					return nil, &retry.NotFoundError{LastError: &types.RequestTokenNotFoundException{}}
+				}
+
+				// The last status is repeated once the progression is exhausted.
+				status := testCase.PendingStatuses[len(testCase.PendingStatuses)-1]
+				if calls < len(testCase.PendingStatuses) {
+					status = testCase.PendingStatuses[calls]
+				}
+				calls++
+
+				return &types.ProgressEvent{
+					Operation:       testCase.PendingOperation,
+					OperationStatus: status,
+					RequestToken:    aws.String(requestToken),
+				}, nil
+			}
+			start := func(ctx context.Context) (*types.ProgressEvent, error) {
+				// The pending request must have completed before a new one is started.
+				if calls > 0 && calls < len(testCase.PendingStatuses) {
+					t.Errorf("started a new request while the pending request is in progress")
+				}
+				starts++
+
+				return &types.ProgressEvent{
+					Operation:       testCase.Operation,
+					OperationStatus: types.OperationStatusInProgress,
+					RequestToken:    aws.String(newRequestToken),
+				}, nil
+			}
+
+			output, err := tfcloudcontrol.ResumeOrStartRequest(ctx, testCase.Operation, testCase.PendingRequestToken, 1*time.Minute, find, start)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if starts != 0 {
+					t.Errorf("started %d requests, want 0", starts)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.StringValue(output.RequestToken), testCase.ExpectedToken; got != want {
+				t.Errorf("RequestToken = %s, want %s", got, want)
+			}
+
+			wantStarts := 0
+			if testCase.ExpectedToken == newRequestToken {
+				wantStarts = 1
+			}
+
+			if starts != wantStarts {
+				t.Errorf("started %d requests, want %d", starts, wantStarts)
+			}
+		})
+	}
+}
+
+type listResourceRequestsClient struct {
+	pages [][]types.ProgressEvent
+	err   error
+}
+
+func (c *listResourceRequestsClient) ListResourceRequests(ctx context.Context, input *cloudcontrol.ListResourceRequestsInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourceRequestsOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	page, _ := strconv.Atoi(aws.StringValue(input.NextToken))
+
+	output := &cloudcontrol.ListResourceRequestsOutput{}
+
+	if page < len(c.pages) {
+		output.ResourceRequestStatusSummaries = c.pages[page]
+	}
+
+	if page+1 < len(c.pages) {
+		output.NextToken = aws.String(strconv.Itoa(page + 1))
+	}
+
+	return output, nil
+}
+
+func TestFindPendingProgressEvent(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	const (
+		typeName   = "AWS::Logs::LogGroup"
+		identifier = "example"
+	)
+
+	testCases := []struct {
+		Name          string
+		Pages         [][]types.ProgressEvent
+		ListError     error
+		ExpectedToken string
+		ExpectError   bool
+	}{
+		{
+			Name: "no requests",
+		},
+		{
+			Name: "request for another resource",
+			Pages: [][]types.ProgressEvent{{
+				{Identifier: aws.String("other"), Operation: types.OperationDelete, RequestToken: aws.String("other"), TypeName: aws.String(typeName)},
+				{Identifier: aws.String(identifier), Operation: types.OperationDelete, RequestToken: aws.String("other-type"), TypeName: aws.String("AWS::SNS::Topic")},
+			}},
+		},
+		{
+			Name: "request for the resource",
+			Pages: [][]types.ProgressEvent{{
+				{Identifier: aws.String("other"), Operation: types.OperationDelete, RequestToken: aws.String("other"), TypeName: aws.String(typeName)},
+				{Identifier: aws.String(identifier), Operation: types.OperationCreate, RequestToken: aws.String("pending"), TypeName: aws.String(typeName)},
+			}},
+			ExpectedToken: "pending",
+		},
+		{
+			Name: "request for the resource on a later page",
+			Pages: [][]types.ProgressEvent{
+				{{Identifier: aws.String("other"), Operation: types.OperationDelete, RequestToken: aws.String("other"), TypeName: aws.String(typeName)}},
+				{{Identifier: aws.String(identifier), Operation: types.OperationDelete, RequestToken: aws.String("pending"), TypeName: aws.String(typeName)}},
+			},
+			ExpectedToken: "pending",
+		},
+		{
+			Name:        "list error",
+			ListError:   errors.New("AccessDeniedException"),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &listResourceRequestsClient{pages: testCase.Pages, err: testCase.ListError}
+
+			output, err := tfcloudcontrol.FindPendingProgressEvent(ctx, conn, typeName, identifier)
+
+			if testCase.ExpectError {
+				if err == nil || tfresource.NotFound(err) {
+					t.Fatalf("expected list error, got: %v", err)
+				}
+
+				return
+			}
+
+			if testCase.ExpectedToken == "" {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.StringValue(output.RequestToken), testCase.ExpectedToken; got != want {
+				t.Errorf("RequestToken = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestCreationToken(t *testing.T) {
+	t.Parallel()
+
+	const (
+		typeName     = "AWS::Logs::LogGroup"
+		desiredState = `{"LogGroupName":"example"}`
+	)
+
+	token := tfcloudcontrol.CreationToken(typeName, "", "", desiredState, 0)
+
+	if !regexp.MustCompile(`^[-A-Za-z0-9+/=]{1,128}$`).MatchString(token) {
+		t.Fatalf("invalid client token: %s", token)
+	}
+
+	if got := tfcloudcontrol.CreationToken(typeName, "", "", desiredState, 0); got != token {
+		t.Errorf("got %s, expected %s for the same configuration", got, token)
+	}
+
+	for _, got := range []string{
+		tfcloudcontrol.CreationToken("AWS::Logs::Destination", "", "", desiredState, 0),
+		tfcloudcontrol.CreationToken(typeName, "00000001", "", desiredState, 0),
+		tfcloudcontrol.CreationToken(typeName, "", "arn:aws:iam::123456789012:role/example", desiredState, 0),
+		tfcloudcontrol.CreationToken(typeName, "", "", `{"LogGroupName":"other"}`, 0),
+		tfcloudcontrol.CreationToken(typeName, "", desiredState, "", 0),
+		tfcloudcontrol.CreationToken(typeName, "", "", desiredState, 1),
+	} {
+		if got == token {
+			t.Errorf("got %s for a different configuration", got)
+		}
+	}
+}
+
+func TestReadUnsupported(t *testing.T) {
+	t.Parallel()
+
+	const (
+		identifier = "example"
+		typeName   = "AWS::Example::Resource"
+	)
+
+	err := fmt.Errorf("operation error CloudControl: GetResource: %w", &types.UnsupportedActionException{
+		Message: aws.String("Resource type AWS::Example::Resource does not support READ action"),
+	})
+
+	if !tfcloudcontrol.ReadUnsupported(err) {
+		t.Errorf("expected read to be unsupported for %s", err)
+	}
+
+	for _, err := range []error{
+		nil,
+		&types.ResourceNotFoundException{},
+		&types.HandlerFailureException{},
+	} {
+		if tfcloudcontrol.ReadUnsupported(err) {
+			t.Errorf("expected read to be supported for %v", err)
+		}
+	}
+
+	// The resource keeps its last known state, so refresh succeeds with a warning.
+	diags := tfcloudcontrol.ReadUnsupportedDiagnostics(diag.Warning, typeName, identifier, err)
+
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("got %d diagnostics, expected %d", got, want)
+	}
+
+	if got, want := diags[0].Summary, "reading Cloud Control API (AWS::Example::Resource) Resource (example): read not supported"; got != want {
+		t.Errorf("got summary %q, expected %q", got, want)
+	}
+
+	if !regexp.MustCompile(`last known state is kept`).MatchString(diags[0].Detail) {
+		t.Errorf("unexpected detail: %s", diags[0].Detail)
+	}
+
+	// The data source has no state to fall back on.
+	if diags := tfcloudcontrol.ReadUnsupportedDiagnostics(diag.Error, typeName, identifier, err); !diags.HasError() {
+		t.Errorf("expected error, got %v", diags)
+	}
+}
+
+func TestProgressEventHookFailure(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		ProgressEvent       *types.ProgressEvent
+		ExpectHookFailure   bool
+		ExpectedFailureMode string
+		ExpectedTypeNames   []string
+	}{
+		{
+			Name: "Control Tower proactive control",
+			ProgressEvent: &types.ProgressEvent{
+				ErrorCode:       types.HandlerErrorCodeInvalidRequest,
+				Operation:       types.OperationCreate,
+				OperationStatus: types.OperationStatusFailed,
+				StatusMessage:   aws.String("The following hook(s)/validation failed: [AWS::ControlTower::Hook]. To troubleshoot hook failures, use the ListHookResults API."),
+				TypeName:        aws.String("AWS::S3::Bucket"),
+			},
+			ExpectHookFailure:   true,
+			ExpectedFailureMode: "FAIL",
+			ExpectedTypeNames:   []string{"AWS::ControlTower::Hook"},
+		},
+		{
+			Name: "several hooks",
+			ProgressEvent: &types.ProgressEvent{
+				ErrorCode:       types.HandlerErrorCodeGeneralServiceException,
+				Operation:       types.OperationUpdate,
+				OperationStatus: types.OperationStatusFailed,
+				StatusMessage:   aws.String("The following hook(s) failed: [Private::Guard::S3Hook, Private::Lambda::TagHook]"),
+				TypeName:        aws.String("AWS::S3::Bucket"),
+			},
+			ExpectHookFailure:   true,
+			ExpectedFailureMode: "FAIL",
+			ExpectedTypeNames:   []string{"Private::Guard::S3Hook", "Private::Lambda::TagHook"},
+		},
+		{
+			Name: "failure mode and resource type in message",
+			ProgressEvent: &types.ProgressEvent{
+				ErrorCode:       types.HandlerErrorCodeInternalFailure,
+				Operation:       types.OperationCreate,
+				OperationStatus: types.OperationStatusFailed,
+				StatusMessage:   aws.String("Hook Private::Guard::S3Hook failed for AWS::S3::Bucket with message: versioning must be enabled. FailureMode: FAIL"),
+				TypeName:        aws.String("AWS::S3::Bucket"),
+			},
+			ExpectHookFailure:   true,
+			ExpectedFailureMode: "FAIL",
+			ExpectedTypeNames:   []string{"Private::Guard::S3Hook"},
+		},
+		{
+			Name: "handler internal failure",
+			ProgressEvent: &types.ProgressEvent{
+				ErrorCode:       types.HandlerErrorCodeInternalFailure,
+				Operation:       types.OperationCreate,
+				OperationStatus: types.OperationStatusFailed,
+				StatusMessage:   aws.String("Internal Failure"),
+				TypeName:        aws.String("AWS::S3::Bucket"),
+			},
+		},
+		{
+			Name: "handler failure mentioning a webhook",
+			ProgressEvent: &types.ProgressEvent{
+				ErrorCode:       types.HandlerErrorCodeGeneralServiceException,
+				Operation:       types.OperationCreate,
+				OperationStatus: types.OperationStatusFailed,
+				StatusMessage:   aws.String(`Resource handler returned message: "webhook delivery failed" (HandlerErrorCode: GeneralServiceException)`),
+				TypeName:        aws.String("AWS::CodeBuild::Project"),
+			},
+		},
+		{
+			Name: "in progress",
+			ProgressEvent: &types.ProgressEvent{
+				Operation:       types.OperationCreate,
+				OperationStatus: types.OperationStatusInProgress,
+				StatusMessage:   aws.String("Invoking the following hook(s): [AWS::ControlTower::Hook]; failures will be reported"),
+				TypeName:        aws.String("AWS::S3::Bucket"),
+			},
+		},
+		{
+			Name: "no progress event",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcloudcontrol.ProgressEventHookFailure(testCase.ProgressEvent)
+
+			if !testCase.ExpectHookFailure {
+				if got != nil {
+					t.Fatalf("unexpected hook failure: %s", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatal("expected hook failure")
+			}
+
+			if got.FailureMode != testCase.ExpectedFailureMode {
+				t.Errorf("got failure mode %q, expected %q", got.FailureMode, testCase.ExpectedFailureMode)
+			}
+
+			if diff := cmp.Diff(got.TypeNames, testCase.ExpectedTypeNames); diff != "" {
+				t.Errorf("unexpected type names difference: %s", diff)
+			}
+
+			if got.ErrorCode != testCase.ProgressEvent.ErrorCode {
+				t.Errorf("got error code %q, expected %q", got.ErrorCode, testCase.ProgressEvent.ErrorCode)
+			}
+		})
+	}
+}
+
+func TestProgressEventFailureDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	const (
+		identifier = "example"
+		typeName   = "AWS::S3::Bucket"
+	)
+
+	err := errors.New("unexpected state 'FAILED', wanted target 'SUCCESS'")
+	hookEvent := &types.ProgressEvent{
+		ErrorCode:       types.HandlerErrorCodeInvalidRequest,
+		Operation:       types.OperationCreate,
+		OperationStatus: types.OperationStatusFailed,
+		StatusMessage:   aws.String("The following hook(s)/validation failed: [AWS::ControlTower::Hook]."),
+		TypeName:        aws.String(typeName),
+	}
+
+	diags := tfcloudcontrol.ProgressEventFailureDiagnostics(hookEvent, err, typeName, identifier, "create")
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("got %d diagnostics, expected %d", got, want)
+	}
+
+	if got, want := diags[0].Summary, "Cloud Control API (AWS::S3::Bucket) Resource (example) create rejected by CloudFormation Hook"; got != want {
+		t.Errorf("got summary %q, expected %q", got, want)
+	}
+
+	if !regexp.MustCompile(`^The create was rejected by CloudFormation Hook \(AWS::ControlTower::Hook\) with failure mode FAIL: .*\n\nThe resource's handler did not fail \(error code: InvalidRequest\)`).MatchString(diags[0].Detail) {
+		t.Errorf("unexpected detail: %s", diags[0].Detail)
+	}
+
+	handlerEvent := &types.ProgressEvent{
+		ErrorCode:       types.HandlerErrorCodeInternalFailure,
+		Operation:       types.OperationCreate,
+		OperationStatus: types.OperationStatusFailed,
+		StatusMessage:   aws.String("Internal Failure"),
+		TypeName:        aws.String(typeName),
+	}
+
+	diags = tfcloudcontrol.ProgressEventFailureDiagnostics(handlerEvent, err, typeName, identifier, "create")
+
+	if got, want := diags[0].Summary, "waiting for Cloud Control API (AWS::S3::Bucket) Resource (example) create: "+err.Error(); got != want {
+		t.Errorf("got summary %q, expected %q", got, want)
+	}
+}
+
+func TestDesiredStateWithDrift(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		DesiredState string
+		Properties   string
+		Expected     string
+	}{
+		{
+			Name:         "no drift",
+			DesiredState: `{"LogGroupName":"test","RetentionInDays":14}`,
+			Properties:   `{"LogGroupName":"test","RetentionInDays":14}`,
+			Expected:     `{"LogGroupName":"test","RetentionInDays":14}`,
+		},
+		{
+			Name:         "server-added top-level defaults",
+			DesiredState: `{"GroupDescription":"test"}`,
+			Properties:   `{"GroupDescription":"test","GroupId":"sg-12345678","SecurityGroupEgress":[{"CidrIp":"0.0.0.0/0","IpProtocol":"-1"}]}`,
+			Expected:     `{"GroupDescription":"test"}`,
+		},
+		{
+			Name:         "server-added nested defaults",
+			DesiredState: `{"SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","FromPort":80,"IpProtocol":"tcp","ToPort":80}]}`,
+			Properties:   `{"SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","Description":"","FromPort":80,"IpProtocol":"tcp","ToPort":80}]}`,
+			Expected:     `{"SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","FromPort":80,"IpProtocol":"tcp","ToPort":80}]}`,
+		},
+		{
+			Name:         "drift",
+			DesiredState: `{"LogGroupName":"test","RetentionInDays":14}`,
+			Properties:   `{"LogGroupName":"test","RetentionInDays":7,"Arn":"arn:aws:logs:us-west-2:123456789012:log-group:test:*"}`,
+			Expected:     `{"LogGroupName":"test","RetentionInDays":7}`,
+		},
+		{
+			Name:         "array drift without server-added defaults",
+			DesiredState: `{"SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","FromPort":80,"IpProtocol":"tcp","ToPort":80}]}`,
+			Properties:   `{"SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","Description":"","FromPort":80,"IpProtocol":"tcp","ToPort":80},{"CidrIp":"10.0.0.0/8","Description":"","FromPort":443,"IpProtocol":"tcp","ToPort":443}]}`,
+			Expected:     `{"SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","FromPort":80,"IpProtocol":"tcp","ToPort":80},{"CidrIp":"10.0.0.0/8","FromPort":443,"IpProtocol":"tcp","ToPort":443}]}`,
+		},
+		{
+			Name:         "array of scalars drift",
+			DesiredState: `{"Names":["a","b"]}`,
+			Properties:   `{"Names":["a","c"]}`,
+			Expected:     `{"Names":["a","c"]}`,
+		},
+		{
+			Name:         "type change",
+			DesiredState: `{"Setting":{"Name":"a"}}`,
+			Properties:   `{"Setting":"a"}`,
+			Expected:     `{"Setting":"a"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudcontrol.DesiredStateWithDrift(testCase.DesiredState, testCase.Properties, "")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		id                 string
+		expectedIdentifier string
+		expectedRegion     string
+	}{
+		{
+			name:               "identifier",
+			id:                 "example",
+			expectedIdentifier: "example",
+		},
+		{
+			name:               "identifier and region",
+			id:                 "example,us-west-2",
+			expectedIdentifier: "example",
+			expectedRegion:     "us-west-2",
+		},
+		{
+			name:               "compound identifier and region",
+			id:                 "example|0123456789,eu-central-1",
+			expectedIdentifier: "example|0123456789",
+			expectedRegion:     "eu-central-1",
+		},
+		{
+			name:               "identifier containing separator",
+			id:                 "example,other",
+			expectedIdentifier: "example,other",
+		},
+		{
+			name:               "region only",
+			id:                 ",us-west-2",
+			expectedIdentifier: ",us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			identifier, region := tfcloudcontrol.ResourceParseResourceID(testCase.id)
+
+			if identifier != testCase.expectedIdentifier {
+				t.Errorf("got identifier %s, expected %s", identifier, testCase.expectedIdentifier)
+			}
+
+			if region != testCase.expectedRegion {
+				t.Errorf("got region %s, expected %s", region, testCase.expectedRegion)
+			}
+
+			if testCase.expectedRegion != "" {
+				if got := tfcloudcontrol.ResourceCreateResourceID(identifier, region); got != testCase.id {
+					t.Errorf("got ID %s, expected %s", got, testCase.id)
+				}
+			}
+		})
+	}
+}
+
+func TestAccCloudControlResource_creationTokenInterruptedCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+	typeName := "AWS::Logs::LogGroup"
+	desiredState := fmt.Sprintf(`{"LogGroupName":%q}`, rName)
+	token := tfcloudcontrol.CreationToken(typeName, "", "", desiredState, 0)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Simulate a create that was interrupted before the resource was recorded in state.
+				// As log group names are unique, a second create request would fail.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlClient()
+
+					_, err := conn.CreateResource(ctx, &cloudcontrol.CreateResourceInput{
+						ClientToken:  aws.String(token),
+						DesiredState: aws.String(desiredState),
+						TypeName:     aws.String(typeName),
+					})
+
+					if err != nil {
+						t.Fatalf("creating Cloud Control API (%s) Resource: %s", typeName, err)
+					}
+				},
+				Config: testAccResourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "creation_token", token),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResource_creationTokenIdenticalConfigurations(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Resources with identical configurations must each be created with their own client token.
+				Config: testAccResourceConfig_identicalConfigurations,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aws_cloudcontrolapi_resource.test.0", "creation_token"),
+					resource.TestCheckResourceAttrSet("aws_cloudcontrolapi_resource.test.1", "creation_token"),
+					testAccCheckResourceAttrsDiffer("aws_cloudcontrolapi_resource.test.0", "aws_cloudcontrolapi_resource.test.1", "id"),
+					testAccCheckResourceAttrsDiffer("aws_cloudcontrolapi_resource.test.0", "aws_cloudcontrolapi_resource.test.1", "creation_token"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResource_timeouts(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_timeouts(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`^\{.*\}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResource_autoTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceConfig_autoTags(rName, false, "key2", "value2"),
+				ExpectError: regexp.MustCompile(`"tags" can only be set when "auto_tags" is true`),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("key1", "value1"),
+					testAccResourceConfig_autoTags(rName, true, "key2", "value2"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key2", "value2"),
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"Key":"key1","Value":"value1"`)),
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"Key":"key2","Value":"value2"`)),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("key1", "value1updated"),
+					testAccResourceConfig_autoTags(rName, true, "key2", "value2updated"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key2", "value2updated"),
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"Key":"key1","Value":"value1updated"`)),
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"Key":"key2","Value":"value2updated"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResource_retainOnDelete(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_retainOnDelete(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "retain_on_delete", "true"),
+				),
+			},
+			{
+				Config: "# Empty config",
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLogGroupExists(ctx, rName),
+				),
+			},
+			{
+				// Clean up the retained log group.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn()
+
+					_, err := conn.DeleteLogGroupWithContext(ctx, &cloudwatchlogs.DeleteLogGroupInput{
+						LogGroupName: aws.String(rName),
+					})
+
+					if err != nil {
+						t.Fatalf("deleting CloudWatch Logs Log Group (%s): %s", rName, err)
+					}
+				},
+				Config: "# Empty config",
+			},
+		},
+	})
+}
+
 func TestAccCloudControlResource_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -262,6 +1304,68 @@ func TestAccCloudControlResource_DesiredState_integerValueUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudControlResource_DesiredState_integerValueDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_desiredStateIntegerValue(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"RetentionInDays":14`)),
+					testAccCheckLogGroupRetentionInDaysUpdated(ctx, rName, 7),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceConfig_desiredStateIntegerValue(rName, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"RetentionInDays":14`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResource_DesiredState_serverDefaults(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_desiredStateServerDefaults(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// AWS adds the default egress rule, which isn't in desired_state.
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"SecurityGroupEgress":\[`)),
+				),
+			},
+			{
+				// The default egress rule and the ingress rule's defaults don't cause a difference.
+				Config:   testAccResourceConfig_desiredStateServerDefaults(rName, 80),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccResourceConfig_desiredStateServerDefaults(rName, 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"FromPort":443`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudControlResource_DesiredState_invalidPropertyName(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -385,6 +1489,32 @@ func TestAccCloudControlResource_DesiredState_objectValueUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudControlResource_DesiredState_objectValueNormalized(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_desiredStateObjectValue2(rName, "key2", "value2", "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"Value":"value1"`)),
+					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`"Value":"value2"`)),
+				),
+			},
+			{
+				Config:   testAccResourceConfig_desiredStateObjectValue2(rName, "key2", "value2", "key1", "value1"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudControlResource_DesiredState_stringValueAdded(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -517,6 +1647,37 @@ func TestAccCloudControlResource_lambdaFunction(t *testing.T) {
 	})
 }
 
+func TestAccCloudControlResource_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+	alternateResourceName := "aws_cloudcontrolapi_resource.alternate"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_region(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "region", ""),
+					resource.TestMatchResourceAttr(resourceName, "outputs.Arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:logs:%s:\d{12}:log-group:%s:\*$`, acctest.Region(), rName))),
+					resource.TestCheckResourceAttr(alternateResourceName, "id", fmt.Sprintf("%s,%s", rName, acctest.AlternateRegion())),
+					resource.TestCheckResourceAttr(alternateResourceName, "region", acctest.AlternateRegion()),
+					resource.TestMatchResourceAttr(alternateResourceName, "outputs.Arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:logs:%s:\d{12}:log-group:%s:\*$`, acctest.AlternateRegion(), rName))),
+				),
+			},
+			{
+				Config:   testAccResourceConfig_region(rName, acctest.AlternateRegion()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlClient()
@@ -526,7 +1687,16 @@ func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
 				continue
 			}
 
-			_, err := tfcloudcontrol.FindResource(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["type_name"], "", "")
+			identifier, region := tfcloudcontrol.ResourceParseResourceID(rs.Primary.ID)
+			var optFns []func(*cloudcontrol.Options)
+
+			if region != "" {
+				optFns = append(optFns, func(o *cloudcontrol.Options) {
+					o.Region = region
+				})
+			}
+
+			_, err := tfcloudcontrol.FindResource(ctx, conn, identifier, rs.Primary.Attributes["type_name"], "", "", optFns...)
 
 			if tfresource.NotFound(err) {
 				continue
@@ -543,6 +1713,52 @@ func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckResourceAttrsDiffer checks that the value of the attribute `key` differs between two resources.
+func testAccCheckResourceAttrsDiffer(n1, n2, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs1, ok := s.RootModule().Resources[n1]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n1)
+		}
+
+		rs2, ok := s.RootModule().Resources[n2]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n2)
+		}
+
+		if v1, v2 := rs1.Primary.Attributes[key], rs2.Primary.Attributes[key]; v1 == v2 {
+			return fmt.Errorf("%s: %s and %s have the same value: %s", key, n1, n2, v1)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckLogGroupExists checks that the log group exists, whether or not it is in Terraform state.
+func testAccCheckLogGroupExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn()
+
+		_, err := tflogs.FindLogGroupByName(ctx, conn, name)
+
+		return err
+	}
+}
+
+// testAccCheckLogGroupRetentionInDaysUpdated modifies the log group's retention outside of Terraform.
+func testAccCheckLogGroupRetentionInDaysUpdated(ctx context.Context, name string, retentionInDays int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn()
+
+		_, err := conn.PutRetentionPolicyWithContext(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(name),
+			RetentionInDays: aws.Int64(retentionInDays),
+		})
+
+		return err
+	}
+}
+
 func testAccResourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
@@ -555,6 +1771,88 @@ resource "aws_cloudcontrolapi_resource" "test" {
 `, rName)
 }
 
+// The log groups' names are generated, so their configurations are identical.
+const testAccResourceConfig_identicalConfigurations = `
+resource "aws_cloudcontrolapi_resource" "test" {
+  count = 2
+
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    RetentionInDays = 7
+  })
+}
+`
+
+func testAccResourceConfig_retainOnDelete(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name        = "AWS::Logs::LogGroup"
+  retain_on_delete = true
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+`, rName)
+}
+
+func testAccResourceConfig_region(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+resource "aws_cloudcontrolapi_resource" "alternate" {
+  type_name = "AWS::Logs::LogGroup"
+  region    = %[2]q
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+`, rName, region)
+}
+
+func testAccResourceConfig_timeouts(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+
+  timeouts {
+    create = "3h"
+    update = "3h"
+    delete = "3h"
+  }
+}
+`, rName)
+}
+
+func testAccResourceConfig_autoTags(rName string, autoTags bool, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+  auto_tags = %[2]t
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, autoTags, tagKey1, tagValue1)
+}
+
 func testAccResourceConfig_desiredStateBooleanValue(rName string, booleanValue bool) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
@@ -619,6 +1917,27 @@ resource "aws_cloudcontrolapi_resource" "test" {
 `, rName)
 }
 
+func testAccResourceConfig_desiredStateServerDefaults(rName string, port int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::EC2::SecurityGroup"
+
+  desired_state = jsonencode({
+    GroupDescription = %[1]q
+    GroupName        = %[1]q
+    VpcId            = aws_vpc.test.id
+
+    SecurityGroupIngress = [{
+      CidrIp     = "10.0.0.0/8"
+      FromPort   = %[2]d
+      IpProtocol = "tcp"
+      ToPort     = %[2]d
+    }]
+  })
+}
+`, rName, port))
+}
+
 func testAccResourceConfig_desiredStateInvalidPropertyName(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
@@ -661,6 +1980,34 @@ resource "aws_cloudcontrolapi_resource" "test" {
 `, rName, key1, value1)
 }
 
+func testAccResourceConfig_desiredStateObjectValue2(rName, key1, value1, key2, value2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::ECS::Cluster"
+
+  desired_state = jsonencode({
+    ClusterName = %[1]q
+    ClusterSettings = [
+      {
+        Name  = "containerInsights"
+        Value = "enabled"
+      }
+    ]
+    Tags = [
+      {
+        Key   = %[2]q
+        Value = %[3]q
+      },
+      {
+        Key   = %[4]q
+        Value = %[5]q
+      }
+    ]
+  })
+}
+`, rName, key1, value1, key2, value2)
+}
+
 func testAccResourceConfig_desiredStateObjectValueRemoved(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
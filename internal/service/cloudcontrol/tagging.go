@@ -0,0 +1,273 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// tagsProperty describes the top-level property in which a resource type stores its tags.
+type tagsProperty struct {
+	name string
+	// isList is true if tags are a list of Key/Value objects and false if they are a map of tag values.
+	isList bool
+}
+
+// tagsPropertyFromSchema returns the tags property declared by a CloudFormation resource type schema,
+// or nil if the resource type is not taggable or its tags property has an unsupported shape.
+func tagsPropertyFromSchema(resourceSchema string) (*tagsProperty, error) {
+	type jsonSchemaProperty struct {
+		Ref  string      `json:"$ref"`
+		Type interface{} `json:"type"`
+	}
+
+	var doc struct {
+		Definitions map[string]jsonSchemaProperty `json:"definitions"`
+		Properties  map[string]jsonSchemaProperty `json:"properties"`
+		Tagging     *struct {
+			TagProperty string `json:"tagProperty"`
+			Taggable    bool   `json:"taggable"`
+		} `json:"tagging"`
+	}
+
+	if err := json.Unmarshal([]byte(resourceSchema), &doc); err != nil {
+		return nil, fmt.Errorf("decoding CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	if doc.Tagging == nil || !doc.Tagging.Taggable {
+		return nil, nil
+	}
+
+	path := doc.Tagging.TagProperty
+
+	if path == "" {
+		path = "/properties/Tags"
+	}
+
+	name := strings.TrimPrefix(path, "/properties/")
+
+	if name == path || name == "" || strings.Contains(name, "/") {
+		return nil, nil
+	}
+
+	property, ok := doc.Properties[name]
+
+	if !ok {
+		return nil, nil
+	}
+
+	// Follow references to definitions, guarding against cycles.
+	for i := 0; property.Ref != "" && i < len(doc.Definitions); i++ {
+		if property, ok = doc.Definitions[strings.TrimPrefix(property.Ref, "#/definitions/")]; !ok {
+			return nil, nil
+		}
+	}
+
+	switch property.Type {
+	case "array":
+		return &tagsProperty{name: name, isList: true}, nil
+	case "object":
+		return &tagsProperty{name: name}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// tags returns the tags in the tags property of the specified JSON object.
+func (p *tagsProperty) tags(document map[string]interface{}) map[string]string {
+	tags := make(map[string]string)
+
+	if p.isList {
+		tfList, _ := document[p.name].([]interface{})
+
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if key, ok := tfMap["Key"].(string); ok {
+				tags[key], _ = tfMap["Value"].(string)
+			}
+		}
+	} else {
+		tfMap, _ := document[p.name].(map[string]interface{})
+
+		for key, value := range tfMap {
+			tags[key], _ = value.(string)
+		}
+	}
+
+	return tags
+}
+
+// mergeTags adds `tags` to the tags property of the specified JSON object.
+// Tags already present in the object take precedence.
+func (p *tagsProperty) mergeTags(document map[string]interface{}, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	existing := p.tags(document)
+	keys := make([]string, 0, len(tags))
+
+	for key := range tags {
+		if _, ok := existing[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	sort.Strings(keys)
+
+	if p.isList {
+		tfList, _ := document[p.name].([]interface{})
+
+		for _, key := range keys {
+			tfList = append(tfList, map[string]interface{}{
+				"Key":   key,
+				"Value": tags[key],
+			})
+		}
+
+		document[p.name] = tfList
+	} else {
+		tfMap, ok := document[p.name].(map[string]interface{})
+
+		if !ok {
+			tfMap = make(map[string]interface{})
+		}
+
+		for _, key := range keys {
+			tfMap[key] = tags[key]
+		}
+
+		document[p.name] = tfMap
+	}
+}
+
+// removeTags removes the tags with the specified keys from the tags property of the specified JSON object.
+func (p *tagsProperty) removeTags(document map[string]interface{}, keys []string) {
+	remove := make(map[string]bool, len(keys))
+
+	for _, key := range keys {
+		remove[key] = true
+	}
+
+	if p.isList {
+		tfList, ok := document[p.name].([]interface{})
+
+		if !ok {
+			return
+		}
+
+		var kept []interface{}
+
+		for _, tfMapRaw := range tfList {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				if key, ok := tfMap["Key"].(string); ok && remove[key] {
+					continue
+				}
+			}
+
+			kept = append(kept, tfMapRaw)
+		}
+
+		if len(kept) == 0 {
+			delete(document, p.name)
+		} else {
+			document[p.name] = kept
+		}
+	} else {
+		tfMap, ok := document[p.name].(map[string]interface{})
+
+		if !ok {
+			return
+		}
+
+		for key := range remove {
+			delete(tfMap, key)
+		}
+
+		if len(tfMap) == 0 {
+			delete(document, p.name)
+		}
+	}
+}
+
+// desiredStateWithTags returns `desiredState` with `tags` merged into the resource type's tags property.
+// Tags set explicitly in `desiredState` take precedence.
+func desiredStateWithTags(desiredState string, p *tagsProperty, tags map[string]string) (string, error) {
+	if p == nil || len(tags) == 0 {
+		return desiredState, nil
+	}
+
+	var desired map[string]interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", fmt.Errorf("decoding desired_state JSON: %w", err)
+	}
+
+	p.mergeTags(desired, tags)
+
+	b, err := json.Marshal(desired)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding desired_state JSON: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// managedTags returns the tags in the resource's current `properties` that are not set explicitly in `desiredState`,
+// i.e. those managed via the tags and tags_all attributes, and `properties` with those tags removed
+// so that they are not compared against `desiredState` when detecting drift.
+func managedTags(desiredState, properties string, p *tagsProperty) (map[string]string, string, error) {
+	if p == nil || properties == "" {
+		return nil, properties, nil
+	}
+
+	var desired, current map[string]interface{}
+
+	if desiredState != "" {
+		if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+			return nil, "", fmt.Errorf("decoding desired_state JSON: %w", err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(properties), &current); err != nil {
+		return nil, "", fmt.Errorf("decoding properties JSON: %w", err)
+	}
+
+	explicit := p.tags(desired)
+	tags := make(map[string]string)
+	var keys []string
+
+	for key, value := range p.tags(current) {
+		if _, ok := explicit[key]; ok {
+			continue
+		}
+
+		tags[key] = value
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return tags, properties, nil
+	}
+
+	p.removeTags(current, keys)
+
+	b, err := json.Marshal(current)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("encoding properties JSON: %w", err)
+	}
+
+	return tags, string(b), nil
+}
@@ -0,0 +1,281 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const (
+	testTagsMapSchema = `{
+  "typeName": "AWS::Example::MapTags",
+  "properties": {
+    "Name": {"type": "string"},
+    "Tags": {
+      "type": "object",
+      "patternProperties": {"^.+$": {"type": "string"}}
+    }
+  },
+  "tagging": {"taggable": true, "tagProperty": "/properties/Tags"}
+}`
+	testTagsListSchema = `{
+  "typeName": "AWS::Example::ListTags",
+  "definitions": {
+    "Tag": {
+      "type": "object",
+      "properties": {"Key": {"type": "string"}, "Value": {"type": "string"}}
+    },
+    "Tags": {
+      "type": "array",
+      "items": {"$ref": "#/definitions/Tag"}
+    }
+  },
+  "properties": {
+    "Name": {"type": "string"},
+    "TagList": {"$ref": "#/definitions/Tags"}
+  },
+  "tagging": {"taggable": true, "tagProperty": "/properties/TagList"}
+}`
+)
+
+func TestTagsPropertyFromSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		schema        string
+		expected      *tagsProperty
+		expectedError bool
+	}{
+		{
+			name:     "map",
+			schema:   testTagsMapSchema,
+			expected: &tagsProperty{name: "Tags"},
+		},
+		{
+			name:     "list via definitions",
+			schema:   testTagsListSchema,
+			expected: &tagsProperty{name: "TagList", isList: true},
+		},
+		{
+			name:     "default tag property",
+			schema:   `{"properties": {"Tags": {"type": "array"}}, "tagging": {"taggable": true}}`,
+			expected: &tagsProperty{name: "Tags", isList: true},
+		},
+		{
+			name:   "no tagging",
+			schema: `{"properties": {"Tags": {"type": "array"}}}`,
+		},
+		{
+			name:   "not taggable",
+			schema: `{"properties": {"Tags": {"type": "array"}}, "tagging": {"taggable": false}}`,
+		},
+		{
+			name:   "nested tag property",
+			schema: `{"properties": {"Config": {"type": "object"}}, "tagging": {"taggable": true, "tagProperty": "/properties/Config/Tags"}}`,
+		},
+		{
+			name:   "missing tag property",
+			schema: `{"properties": {"Name": {"type": "string"}}, "tagging": {"taggable": true}}`,
+		},
+		{
+			name:   "unsupported tag property type",
+			schema: `{"properties": {"Tags": {"type": "string"}}, "tagging": {"taggable": true}}`,
+		},
+		{
+			name:          "invalid JSON",
+			schema:        `{`,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tagsPropertyFromSchema(testCase.schema)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDesiredStateWithTags(t *testing.T) {
+	t.Parallel()
+
+	tags := map[string]string{
+		"Environment": "test",
+		"Owner":       "provider",
+	}
+
+	testCases := []struct {
+		name         string
+		desiredState string
+		property     *tagsProperty
+		tags         map[string]string
+		expected     string
+	}{
+		{
+			name:         "not taggable",
+			desiredState: `{"Name":"example"}`,
+			tags:         tags,
+			expected:     `{"Name":"example"}`,
+		},
+		{
+			name:         "no tags",
+			desiredState: `{"Name":"example"}`,
+			property:     &tagsProperty{name: "Tags"},
+			expected:     `{"Name":"example"}`,
+		},
+		{
+			name:         "map without tags",
+			desiredState: `{"Name":"example"}`,
+			property:     &tagsProperty{name: "Tags"},
+			tags:         tags,
+			expected:     `{"Name":"example","Tags":{"Environment":"test","Owner":"provider"}}`,
+		},
+		{
+			name:         "map with explicit tags",
+			desiredState: `{"Name":"example","Tags":{"Owner":"explicit","Team":"a"}}`,
+			property:     &tagsProperty{name: "Tags"},
+			tags:         tags,
+			expected:     `{"Name":"example","Tags":{"Environment":"test","Owner":"explicit","Team":"a"}}`,
+		},
+		{
+			name:         "list without tags",
+			desiredState: `{"Name":"example"}`,
+			property:     &tagsProperty{name: "TagList", isList: true},
+			tags:         tags,
+			expected:     `{"Name":"example","TagList":[{"Key":"Environment","Value":"test"},{"Key":"Owner","Value":"provider"}]}`,
+		},
+		{
+			name:         "list with explicit tags",
+			desiredState: `{"Name":"example","TagList":[{"Key":"Owner","Value":"explicit"}]}`,
+			property:     &tagsProperty{name: "TagList", isList: true},
+			tags:         tags,
+			expected:     `{"Name":"example","TagList":[{"Key":"Owner","Value":"explicit"},{"Key":"Environment","Value":"test"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := desiredStateWithTags(testCase.desiredState, testCase.property, testCase.tags)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testCheckJSONEqual(t, got, testCase.expected)
+		})
+	}
+}
+
+func TestManagedTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		desiredState       string
+		properties         string
+		property           *tagsProperty
+		expectedTags       map[string]string
+		expectedProperties string
+	}{
+		{
+			name:               "not taggable",
+			desiredState:       `{"Name":"example"}`,
+			properties:         `{"Name":"example","Tags":{"Environment":"test"}}`,
+			expectedProperties: `{"Name":"example","Tags":{"Environment":"test"}}`,
+		},
+		{
+			name:               "map",
+			desiredState:       `{"Name":"example","Tags":{"Owner":"explicit"}}`,
+			properties:         `{"Name":"example","Tags":{"Environment":"test","Owner":"explicit"}}`,
+			property:           &tagsProperty{name: "Tags"},
+			expectedTags:       map[string]string{"Environment": "test"},
+			expectedProperties: `{"Name":"example","Tags":{"Owner":"explicit"}}`,
+		},
+		{
+			name:               "map without explicit tags",
+			desiredState:       `{"Name":"example"}`,
+			properties:         `{"Name":"example","Tags":{"Environment":"test"}}`,
+			property:           &tagsProperty{name: "Tags"},
+			expectedTags:       map[string]string{"Environment": "test"},
+			expectedProperties: `{"Name":"example"}`,
+		},
+		{
+			name:               "list",
+			desiredState:       `{"Name":"example","TagList":[{"Key":"Owner","Value":"explicit"}]}`,
+			properties:         `{"Name":"example","TagList":[{"Key":"Environment","Value":"test"},{"Key":"Owner","Value":"explicit"}]}`,
+			property:           &tagsProperty{name: "TagList", isList: true},
+			expectedTags:       map[string]string{"Environment": "test"},
+			expectedProperties: `{"Name":"example","TagList":[{"Key":"Owner","Value":"explicit"}]}`,
+		},
+		{
+			name:               "list without tags",
+			desiredState:       `{"Name":"example"}`,
+			properties:         `{"Name":"example"}`,
+			property:           &tagsProperty{name: "TagList", isList: true},
+			expectedTags:       map[string]string{},
+			expectedProperties: `{"Name":"example"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTags, gotProperties, err := managedTags(testCase.desiredState, testCase.properties, testCase.property)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(gotTags, testCase.expectedTags) {
+				t.Errorf("got tags %v, expected %v", gotTags, testCase.expectedTags)
+			}
+
+			testCheckJSONEqual(t, gotProperties, testCase.expectedProperties)
+		})
+	}
+}
+
+func testCheckJSONEqual(t *testing.T, got, expected string) {
+	t.Helper()
+
+	var gotValue, expectedValue interface{}
+
+	if err := json.Unmarshal([]byte(got), &gotValue); err != nil {
+		t.Fatalf("decoding %q: %s", got, err)
+	}
+
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		t.Fatalf("decoding %q: %s", expected, err)
+	}
+
+	if !reflect.DeepEqual(gotValue, expectedValue) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
@@ -5,18 +5,23 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -49,6 +54,11 @@ func ResourceFleet() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			"allow_partial_fulfillment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,13 +70,37 @@ func ResourceFleet() *schema.Resource {
 			"excess_capacity_termination_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      ec2.FleetExcessCapacityTerminationPolicyTermination,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(ec2.FleetExcessCapacityTerminationPolicy_Values(), false),
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return d.Get("type") != ec2.FleetTypeMaintain
 				},
 				DiffSuppressOnRefresh: true,
 			},
+			"fleet_error_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"fleet_instance_set": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -114,6 +148,11 @@ func ResourceFleet() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"launch_template_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -131,12 +170,18 @@ func ResourceFleet() *schema.Resource {
 									"launch_template_id": {
 										Type:     schema.TypeString,
 										Optional: true,
+										Computed: true,
 									},
 									"launch_template_name": {
 										Type:         schema.TypeString,
 										Optional:     true,
+										Computed:     true,
 										ValidateFunc: verify.ValidLaunchTemplateName,
 									},
+									"resolved_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 									"version": {
 										Type:     schema.TypeString,
 										Required: true,
@@ -154,6 +199,10 @@ func ResourceFleet() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
+									"image_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
 									"instance_requirements": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -226,7 +275,11 @@ func ResourceFleet() *schema.Resource {
 													Type:     schema.TypeSet,
 													Optional: true,
 													MaxItems: 400,
-													Elem:     &schema.Schema{Type: schema.TypeString},
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														DiffSuppressFunc: verify.SuppressEquivalentStringCaseInsensitive,
+													},
+													Set: hashStringCaseInsensitive,
 												},
 												"bare_metal": {
 													Type:         schema.TypeString,
@@ -431,20 +484,32 @@ func ResourceFleet() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
-									// Pending AWS to provide this attribute back in the `Describe` call
-									// "placement": {
-									// 	Type:     schema.TypeList,
-									// 	Optional: true,
-									// 	MaxItems: 1,
-									// 	Elem: &schema.Resource{
-									// 		Schema: map[string]*schema.Schema{
-									// 			"group_name": {
-									// 				Type:     schema.TypeString,
-									// 				Optional: true,
-									// 			},
-									// 		},
-									// 	},
-									// },
+									"placement": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"affinity": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"availability_zone": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"group_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tenancy": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(ec2.Tenancy_Values(), false),
+												},
+											},
+										},
+									},
 									"priority": {
 										Type:     schema.TypeFloat,
 										Optional: true,
@@ -472,30 +537,33 @@ func ResourceFleet() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allocation_strategy": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							Default:      FleetOnDemandAllocationStrategyLowestPrice,
-							ValidateFunc: validation.StringInSlice(FleetOnDemandAllocationStrategy_Values(), false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          FleetOnDemandAllocationStrategyLowestPrice,
+							ValidateFunc:     validation.StringInSlice(fleetAllocationStrategyValues(FleetOnDemandAllocationStrategy_Values()), false),
+							DiffSuppressFunc: suppressEquivalentFleetAllocationStrategy,
 						},
-						// Pending AWS to provide this attribute back in the `Describe` call
-						// "capacity_reservation_options": {
-						// 	Type:     schema.TypeList,
-						// 	Optional: true,
-						// 	MaxItems: 1,
-						// 	Elem: &schema.Resource{
-						// 		Schema: map[string]*schema.Schema{
-						// 			"usage_strategy": {
-						// 				Type:         schema.TypeString,
-						// 				Optional:     true,
-						// 				ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
-						// 			},
-						// 		},
-						// 	},
-						// },
-						"max_total_price": {
-							Type:     schema.TypeString,
+						"capacity_reservation_options": {
+							Type:     schema.TypeList,
 							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"usage_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
+									},
+								},
+							},
+						},
+						"max_total_price": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEquivalentFleetPrice,
 						},
 						"min_target_capacity": {
 							Type:     schema.TypeInt,
@@ -512,6 +580,9 @@ func ResourceFleet() *schema.Resource {
 					},
 				},
 			},
+			// ModifyFleet only supports changing the excess capacity termination policy,
+			// launch template configurations and target capacity, so changing
+			// ReplaceUnhealthyInstances requires a new fleet, even for maintain fleets.
 			"replace_unhealthy_instances": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -522,15 +593,16 @@ func ResourceFleet() *schema.Resource {
 				Optional:         true,
 				ForceNew:         true,
 				MaxItems:         1,
-				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				DiffSuppressFunc: suppressMissingFleetSpotOptions,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allocation_strategy": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							Default:      SpotAllocationStrategyLowestPrice,
-							ValidateFunc: validation.StringInSlice(SpotAllocationStrategy_Values(), false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          SpotAllocationStrategyLowestPrice,
+							ValidateFunc:     validation.StringInSlice(fleetAllocationStrategyValues(SpotAllocationStrategy_Values()), false),
+							DiffSuppressFunc: suppressEquivalentFleetAllocationStrategy,
 						},
 						"instance_interruption_behavior": {
 							Type:         schema.TypeString,
@@ -596,61 +668,20 @@ func ResourceFleet() *schema.Resource {
 							ValidateFunc: validation.StringInSlice(ec2.DefaultTargetCapacityType_Values(), false),
 						},
 						"on_demand_target_capacity": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							ForceNew: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// Show difference for new resources
-								if d.Id() == "" {
-									return false
-								}
-								// Show difference if value is configured
-								if new != "0" {
-									return false
-								}
-								// Show difference if existing state reflects different default type
-								defaultTargetCapacityTypeO, _ := d.GetChange("target_capacity_specification.0.default_target_capacity_type")
-								if defaultTargetCapacityTypeO.(string) != ec2.DefaultTargetCapacityTypeOnDemand {
-									return false
-								}
-								// Show difference if existing state reflects different total capacity
-								oldInt, err := strconv.Atoi(old)
-								if err != nil {
-									log.Printf("[WARN] %s DiffSuppressFunc error converting %s to integer: %s", k, old, err)
-									return false
-								}
-								totalTargetCapacityO, _ := d.GetChange("target_capacity_specification.0.total_target_capacity")
-								return oldInt == totalTargetCapacityO.(int)
-							},
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: fleetTargetCapacityDiffSuppress(ec2.DefaultTargetCapacityTypeOnDemand),
 						},
 						"spot_target_capacity": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							ForceNew: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// Show difference for new resources
-								if d.Id() == "" {
-									return false
-								}
-								// Show difference if value is configured
-								if new != "0" {
-									return false
-								}
-								// Show difference if existing state reflects different default type
-								defaultTargetCapacityTypeO, _ := d.GetChange("target_capacity_specification.0.default_target_capacity_type")
-								if defaultTargetCapacityTypeO.(string) != ec2.DefaultTargetCapacityTypeSpot {
-									return false
-								}
-								// Show difference if existing state reflects different total capacity
-								oldInt, err := strconv.Atoi(old)
-								if err != nil {
-									log.Printf("[WARN] %s DiffSuppressFunc error converting %s to integer: %s", k, old, err)
-									return false
-								}
-								totalTargetCapacityO, _ := d.GetChange("target_capacity_specification.0.total_target_capacity")
-								return oldInt == totalTargetCapacityO.(int)
-							},
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: fleetTargetCapacityDiffSuppress(ec2.DefaultTargetCapacityTypeSpot),
 						},
+						// ModifyFleet, which is only supported for maintain fleets, can only change the total target capacity;
+						// other changes are rejected with InvalidTargetCapacitySpecification. Instant and request fleets can't be
+						// modified at all. Changing the unit type therefore replaces the fleet, whatever its type.
 						"target_capacity_unit_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -697,13 +728,72 @@ func ResourceFleet() *schema.Resource {
 	}
 }
 
+// suppressEquivalentFleetPrice suppresses differences between price strings that represent the same value,
+// e.g. "1.0" and "1.00", as the API may return a normalized form.
+func suppressEquivalentFleetPrice(k, old, new string, d *schema.ResourceData) bool {
+	oldFloat, err := strconv.ParseFloat(old, 64)
+
+	if err != nil {
+		return false
+	}
+
+	newFloat, err := strconv.ParseFloat(new, 64)
+
+	if err != nil {
+		return false
+	}
+
+	return oldFloat == newFloat
+}
+
+// fleetTargetCapacityDiffSuppress suppresses the difference for an unconfigured on-demand or spot target capacity
+// when its value in state is the one EC2 derives from the total target capacity:
+// the capacity not allocated to the other purchasing option for the default target capacity type, and 0 otherwise.
+func fleetTargetCapacityDiffSuppress(targetCapacityType string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		// Show difference for new resources
+		if d.Id() == "" {
+			return false
+		}
+		// Show difference if value is configured
+		if new != "0" {
+			return false
+		}
+
+		oldInt, err := strconv.Atoi(old)
+		if err != nil {
+			log.Printf("[WARN] %s DiffSuppressFunc error converting %s to integer: %s", k, old, err)
+			return false
+		}
+
+		// Show difference if existing state reflects different default type
+		defaultTargetCapacityTypeO, _ := d.GetChange("target_capacity_specification.0.default_target_capacity_type")
+		if defaultTargetCapacityTypeO.(string) != targetCapacityType {
+			return false
+		}
+
+		otherKey := "target_capacity_specification.0.spot_target_capacity"
+		if targetCapacityType == ec2.DefaultTargetCapacityTypeSpot {
+			otherKey = "target_capacity_specification.0.on_demand_target_capacity"
+		}
+
+		// Show difference if existing state reflects different total capacity
+		totalTargetCapacityO, _ := d.GetChange("target_capacity_specification.0.total_target_capacity")
+		otherTargetCapacityO, _ := d.GetChange(otherKey)
+		return oldInt == totalTargetCapacityO.(int)-otherTargetCapacityO.(int)
+	}
+}
+
 func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	diags = append(diags, fleetInstanceRequirementsWarnings(d.Get("launch_template_config").([]interface{}))...)
+	diags = append(diags, fleetSpotAllocationStrategyWarnings(configuredFleetSpotAllocationStrategy(d.GetRawConfig()))...)
+
 	fleetType := d.Get("type").(string)
 	input := &ec2.CreateFleetInput{
-		LaunchTemplateConfigs:       expandFleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{})),
+		LaunchTemplateConfigs:       expandFleetLaunchTemplateConfigRequestsFromConfig(d),
 		TargetCapacitySpecification: expandTargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})[0].(map[string]interface{})),
 		TagSpecifications:           getTagSpecificationsIn(ctx, ec2.ResourceTypeFleet),
 		Type:                        aws.String(fleetType),
@@ -713,7 +803,7 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.Context = aws.String(v.(string))
 	}
 
-	// This argument is only valid for fleet_type of `maintain`, but was defaulted in the schema above, hence the extra check.
+	// This argument is only valid for fleet_type of `maintain`, but may be left in the configuration for other types, hence the extra check.
 	if v, ok := d.GetOk("excess_capacity_termination_policy"); ok && v != "" && fleetType == ec2.FleetTypeMaintain {
 		input.ExcessCapacityTerminationPolicy = aws.String(v.(string))
 	}
@@ -759,7 +849,8 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	d.SetId(aws.StringValue(output.FleetId))
 
 	// If a request type is fulfilled immediately, we can miss the transition from active to deleted.
-	// Instead of an error here, allow the Read function to trigger recreation.
+	// Request fleets in a deleted state are kept in state by the Read function.
+	// Fleets with a start time remain in the submitted state until it is reached.
 	if input.ValidFrom == nil {
 		targetStates := []string{ec2.FleetStateCodeActive}
 		if fleetType == ec2.FleetTypeRequest {
@@ -771,14 +862,39 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	return append(diags, resourceFleetRead(ctx, d, meta)...)
+	diags = append(diags, resourceFleetRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Instant fleets report the instances that failed to launch synchronously.
+	if fleetType == ec2.FleetTypeInstant {
+		fulfilledCapacity := d.Get("fulfilled_capacity").(float64)
+		targetCapacity := float64(d.Get("target_capacity_specification.0.total_target_capacity").(int))
+
+		if err := instantFleetFulfillmentError(output.Errors, fulfilledCapacity, targetCapacity, d.Get("allow_partial_fulfillment").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Fleet (%s): fulfilled capacity %g of %g: %s", d.Id(), fulfilledCapacity, targetCapacity, err)
+		}
+	}
+
+	return diags
 }
 
 func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	fleet, err := FindFleetByID(ctx, conn, d.Id())
+	var fleet *ec2.FleetData
+	var err error
+
+	// Request fleets are not maintained once fulfilled and transition to a deleted state on their own,
+	// e.g. on reaching valid_until. Keep them in state, reflecting fleet_state, rather than proposing recreation.
+	if d.Get("type").(string) == ec2.FleetTypeRequest {
+		fleet, err = findFleetByIDIncludingDeleted(ctx, conn, d.Id())
+	} else {
+		fleet, err = FindFleetByID(ctx, conn, d.Id())
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Fleet %s not found, removing from state", d.Id())
@@ -797,18 +913,50 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("fleet/%s", d.Id()),
 	}.String()
+	// allow_partial_fulfillment only affects the create request and isn't returned by the API.
+	// Keep the configured value, and record the default on import.
+	d.Set("allow_partial_fulfillment", d.Get("allow_partial_fulfillment").(bool))
 	d.Set("arn", arn)
 	d.Set("context", fleet.Context)
-	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
-	if fleet.Instances != nil {
-		if err := d.Set("fleet_instance_set", flattenFleetInstanceSet(fleet.Instances)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting fleet_instance_set: %s", err)
+	// The excess capacity termination policy only applies to maintain fleets.
+	// The API may omit the default policy for maintain fleets.
+	if aws.StringValue(fleet.Type) == ec2.FleetTypeMaintain {
+		if v := aws.StringValue(fleet.ExcessCapacityTerminationPolicy); v != "" {
+			d.Set("excess_capacity_termination_policy", v)
+		} else {
+			d.Set("excess_capacity_termination_policy", ec2.FleetExcessCapacityTerminationPolicyTermination)
+		}
+	} else {
+		d.Set("excess_capacity_termination_policy", nil)
+	}
+	// Always set the instance set so that a fleet without instances, e.g. one replacing an instant fleet,
+	// doesn't report instances from a previous fleet.
+	instanceSet, instanceIDs := flattenFleetInstanceSet(fleet.Instances), flattenFleetInstanceIDs(fleet.Instances)
+	// DescribeFleets only returns the instances of instant fleets.
+	// A fleet that is still being fulfilled may not have any active instances yet.
+	if fleetType := aws.StringValue(fleet.Type); fleetType == ec2.FleetTypeMaintain || fleetType == ec2.FleetTypeRequest {
+		activeInstances, err := FindFleetInstancesByID(ctx, conn, d.Id())
+
+		if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Fleet (%s) instances: %s", d.Id(), err)
 		}
+
+		instanceSet, instanceIDs = flattenFleetActiveInstanceSet(activeInstances), flattenFleetActiveInstanceIDs(activeInstances)
+	}
+	if err := d.Set("fleet_instance_set", instanceSet); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting fleet_instance_set: %s", err)
+	}
+	if err := d.Set("fleet_error_set", flattenFleetErrorSet(fleet.Errors)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting fleet_error_set: %s", err)
 	}
 	d.Set("fleet_state", fleet.FleetState)
 	d.Set("fulfilled_capacity", fleet.FulfilledCapacity)
 	d.Set("fulfilled_on_demand_capacity", fleet.FulfilledOnDemandCapacity)
-	if err := d.Set("launch_template_config", flattenFleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
+	d.Set("instance_ids", instanceIDs)
+	launchTemplateConfigs := sortFleetLaunchTemplateConfigOverrides(d.Get("launch_template_config").([]interface{}), flattenFleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs))
+	setFleetLaunchTemplateResolvedVersions(launchTemplateConfigs, fleet)
+	setFleetLaunchTemplateOverridePlacements(d.Get("launch_template_config").([]interface{}), launchTemplateConfigs)
+	if err := d.Set("launch_template_config", launchTemplateConfigs); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting launch_template_config: %s", err)
 	}
 	if fleet.OnDemandOptions != nil {
@@ -851,7 +999,11 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("allow_partial_fulfillment", "tags", "tags_all") {
+		if d.HasChange("launch_template_config") {
+			diags = append(diags, fleetInstanceRequirementsWarnings(d.Get("launch_template_config").([]interface{}))...)
+		}
+
 		input := &ec2.ModifyFleetInput{
 			FleetId: aws.String(d.Id()),
 		}
@@ -860,12 +1012,12 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.Context = aws.String(v.(string))
 		}
 
-		// This argument is only valid for fleet_type of `maintain`, but was defaulted in the schema above, hence the extra check.
+		// This argument is only valid for fleet_type of `maintain`, but may be left in the configuration for other types, hence the extra check.
 		if v, ok := d.GetOk("excess_capacity_termination_policy"); ok && v != "" && d.Get("type") == ec2.FleetTypeMaintain {
 			input.ExcessCapacityTerminationPolicy = aws.String(v.(string))
 		}
 
-		input.LaunchTemplateConfigs = expandFleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{}))
+		input.LaunchTemplateConfigs = expandFleetLaunchTemplateConfigRequestsFromConfig(d)
 
 		// InvalidTargetCapacitySpecification: Currently we only support total target capacity modification.
 		// TargetCapacitySpecification: expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
@@ -892,6 +1044,14 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting EC2 Fleet: %s", d.Id())
+	// Request fleets that have been fulfilled or have expired may already be deleted.
+	// Fleets with running or terminating instances are still deleted so that terminate_instances is honored.
+	fleetState := d.Get("fleet_state").(string)
+	if d.Get("type").(string) == ec2.FleetTypeRequest && fleetState == ec2.FleetStateCodeDeleted {
+		log.Printf("[DEBUG] EC2 Fleet (%s) already deleted", d.Id())
+		return diags
+	}
+
 	output, err := conn.DeleteFleetsWithContext(ctx, &ec2.DeleteFleetsInput{
 		FleetIds:           aws.StringSlice([]string{d.Id()}),
 		TerminateInstances: aws.Bool(d.Get("terminate_instances").(bool)),
@@ -905,6 +1065,30 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, ec2.DeleteFleetErrorCodeFleetIdDoesNotExist, ec2.DeleteFleetErrorCodeFleetNotInDeletableState) {
+		deleted := isFleetStateDeleted(fleetState)
+
+		// The fleet may have been deleted outside of Terraform since it was last read.
+		if !deleted {
+			if v, findErr := isFleetDeleted(ctx, conn, d.Id()); findErr == nil && v {
+				log.Printf("[DEBUG] EC2 Fleet (%s) already deleted", d.Id())
+				deleted = true
+			}
+		}
+
+		if deleted {
+			// A fleet deleted without terminating its instances (deleted_running) can't be deleted again,
+			// so terminate its instances directly to honor terminate_instances.
+			if d.Get("terminate_instances").(bool) {
+				if err := terminateFleetInstances(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "deleting EC2 Fleet (%s): %s", d.Id(), err)
+				}
+			}
+
+			return diags
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 Fleet (%s): %s", d.Id(), err)
 	}
@@ -916,13 +1100,13 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		pendingStates := []string{ec2.FleetStateCodeActive}
 		targetStates := []string{ec2.FleetStateCodeDeleted}
 		if d.Get("terminate_instances").(bool) {
-			pendingStates = append(pendingStates, ec2.FleetStateCodeDeletedTerminating)
+			pendingStates = append(pendingStates, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating)
 			delay = 5 * time.Minute
 		} else {
 			targetStates = append(targetStates, ec2.FleetStateCodeDeletedRunning)
 		}
 
-		if _, err := WaitFleet(ctx, conn, d.Id(), pendingStates, targetStates, d.Timeout(schema.TimeoutDelete), delay); err != nil {
+		if err := WaitFleetDeleted(ctx, conn, d.Id(), pendingStates, targetStates, d.Timeout(schema.TimeoutDelete), delay); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -930,126 +1114,535 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
-func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-	if diff.Id() == "" { // New resource.
-		if diff.Get("type").(string) != ec2.FleetTypeMaintain {
-			if v, ok := diff.GetOk("spot_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				tfMap := v.([]interface{})[0].(map[string]interface{})
-				if v, ok := tfMap["maintenance_strategies"].([]interface{}); ok && len(v) > 0 {
-					return errors.New(`EC2 Fleet has an invalid configuration and can not be created. Capacity Rebalance maintenance strategies can only be specified for fleets of type maintain.`)
-				}
-			}
-		}
+// isFleetDeleted returns whether the fleet no longer exists or is in one of the deleted states.
+func isFleetDeleted(ctx context.Context, conn *ec2.EC2, id string) (bool, error) {
+	// Don't call FindFleetByID as it maps the deleted states to NotFoundError.
+	output, err := FindFleet(ctx, conn, &ec2.DescribeFleetsInput{
+		FleetIds: aws.StringSlice([]string{id}),
+	})
+
+	if tfresource.NotFound(err) {
+		return true, nil
 	}
 
-	return nil
+	if err != nil {
+		return false, err
+	}
+
+	return isFleetStateDeleted(aws.StringValue(output.FleetState)), nil
 }
 
-func expandCapacityReservationOptionsRequest(tfMap map[string]interface{}) *ec2.CapacityReservationOptionsRequest {
-	if tfMap == nil {
+// terminateFleetInstances terminates the active instances of the EC2 Fleet with the specified ID
+// and waits for them to be deleted.
+func terminateFleetInstances(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
+	activeInstances, err := FindFleetInstancesByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
 		return nil
 	}
 
-	apiObject := &ec2.CapacityReservationOptionsRequest{}
-
-	if v, ok := tfMap["usage_strategy"].(string); ok && v != "" {
-		apiObject.UsageStrategy = aws.String(v)
+	if err != nil {
+		return fmt.Errorf("reading EC2 Fleet (%s) instances: %w", id, err)
 	}
 
-	return apiObject
-}
+	var instanceIDs []string
 
-func expandFleetLaunchTemplateConfigRequests(tfList []interface{}) []*ec2.FleetLaunchTemplateConfigRequest {
-	if len(tfList) == 0 {
+	for _, v := range activeInstances {
+		instanceIDs = append(instanceIDs, aws.StringValue(v.InstanceId))
+	}
+
+	if len(instanceIDs) == 0 {
 		return nil
 	}
 
-	var apiObjects []*ec2.FleetLaunchTemplateConfigRequest
+	log.Printf("[DEBUG] Terminating EC2 Fleet (%s) instances: %s", id, instanceIDs)
+	_, err = conn.TerminateInstancesWithContext(ctx, &ec2.TerminateInstancesInput{
+		InstanceIds: aws.StringSlice(instanceIDs),
+	})
 
-	for _, tfMapRaw := range tfList {
-		tfMap, ok := tfMapRaw.(map[string]interface{})
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+		return nil
+	}
 
-		if !ok {
-			continue
+	if err != nil {
+		return fmt.Errorf("terminating EC2 Fleet (%s) instances: %w", id, err)
+	}
+
+	for _, instanceID := range instanceIDs {
+		if _, err := WaitInstanceDeleted(ctx, conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) delete: %w", instanceID, err)
 		}
+	}
 
-		apiObject := expandFleetLaunchTemplateConfigRequest(tfMap)
+	return nil
+}
 
-		if apiObject == nil {
-			continue
-		}
+func isFleetStateDeleted(state string) bool {
+	switch state {
+	case ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating:
+		return true
+	default:
+		return false
+	}
+}
 
-		apiObjects = append(apiObjects, apiObject)
+// suppressMissingFleetSpotOptions suppresses the diff caused by omitting spot_options
+// only if the options refreshed into state are the API defaults.
+// ModifyFleet can't change spot options, so removing a non-default block forces a new fleet.
+func suppressMissingFleetSpotOptions(k, old, new string, d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return verify.SuppressMissingOptionalConfigurationBlock(k, old, new, d)
 	}
 
-	return apiObjects
+	if v := rawConfig.GetAttr("spot_options"); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+		return false
+	}
+
+	o, _ := d.GetChange("spot_options")
+
+	return isDefaultFleetSpotOptions(o.([]interface{}))
 }
 
-func expandFleetLaunchTemplateConfigRequest(tfMap map[string]interface{}) *ec2.FleetLaunchTemplateConfigRequest {
-	if tfMap == nil {
-		return nil
+func isDefaultFleetSpotOptions(tfList []interface{}) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return true
 	}
 
-	apiObject := &ec2.FleetLaunchTemplateConfigRequest{}
+	tfMap := tfList[0].(map[string]interface{})
 
-	if v, ok := tfMap["launch_template_specification"].([]interface{}); ok && len(v) > 0 {
-		apiObject.LaunchTemplateSpecification = expandFleetLaunchTemplateSpecificationRequest(v[0].(map[string]interface{}))
+	if v, ok := tfMap["allocation_strategy"].(string); ok && v != "" && !fleetAllocationStrategiesEqual(v, SpotAllocationStrategyLowestPrice) {
+		return false
 	}
 
-	if v, ok := tfMap["override"].([]interface{}); ok && len(v) > 0 {
-		apiObject.Overrides = expandFleetLaunchTemplateOverridesRequests(v)
+	if v, ok := tfMap["instance_interruption_behavior"].(string); ok && v != "" && v != ec2.SpotInstanceInterruptionBehaviorTerminate {
+		return false
 	}
 
-	return apiObject
-}
+	if v, ok := tfMap["instance_pools_to_use_count"].(int); ok && v != 0 && v != 1 {
+		return false
+	}
 
-func expandFleetLaunchTemplateSpecificationRequest(tfMap map[string]interface{}) *ec2.FleetLaunchTemplateSpecificationRequest {
-	if tfMap == nil {
-		return nil
+	if v, ok := tfMap["maintenance_strategies"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return false
 	}
 
-	apiObject := &ec2.FleetLaunchTemplateSpecificationRequest{}
+	return true
+}
 
-	if v, ok := tfMap["launch_template_id"].(string); ok && v != "" {
-		apiObject.LaunchTemplateId = aws.String(v)
+func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" { // New resource.
+		if diff.Get("type").(string) != ec2.FleetTypeMaintain {
+			if v, ok := diff.GetOk("spot_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+				if v, ok := tfMap["maintenance_strategies"].([]interface{}); ok && len(v) > 0 {
+					return errors.New(`EC2 Fleet has an invalid configuration and can not be created. Capacity Rebalance maintenance strategies can only be specified for fleets of type maintain.`)
+				}
+			}
+		}
 	}
 
-	if v, ok := tfMap["launch_template_name"].(string); ok && v != "" {
-		apiObject.LaunchTemplateName = aws.String(v)
+	// ModifyFleet only supports modifying the launch template configurations of fleets of type maintain.
+	if diff.Id() != "" && diff.Get("type").(string) != ec2.FleetTypeMaintain && diff.HasChange("launch_template_config") {
+		if err := diff.ForceNew("launch_template_config"); err != nil {
+			return err
+		}
 	}
 
-	if v, ok := tfMap["version"].(string); ok && v != "" {
-		apiObject.Version = aws.String(v)
+	if diff.NewValueKnown("on_demand_options") && diff.NewValueKnown("type") {
+		if v, ok := diff.GetOk("on_demand_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := validFleetOnDemandOptions(v.([]interface{})[0].(map[string]interface{}), diff.Get("type").(string)); err != nil {
+				return fmt.Errorf("on_demand_options: %w", err)
+			}
+		}
 	}
 
-	return apiObject
+	return nil
 }
 
-func expandFleetLaunchTemplateOverridesRequests(tfList []interface{}) []*ec2.FleetLaunchTemplateOverridesRequest {
-	if len(tfList) == 0 {
-		return nil
-	}
+// validFleetOnDemandOptions validates the on-demand options, in the form accepted by expandOnDemandOptionsRequest,
+// against the fleet type. capacity_reservation_options, min_target_capacity, single_availability_zone and single_instance_type
+// are only supported by instant fleets, and min_target_capacity requires all of the capacity to be in a single Availability Zone or of a single instance type.
+func validFleetOnDemandOptions(tfMap map[string]interface{}, fleetType string) error {
+	capacityReservationOptions, _ := tfMap["capacity_reservation_options"].([]interface{})
+	minTargetCapacity, _ := tfMap["min_target_capacity"].(int)
+	singleAvailabilityZone, _ := tfMap["single_availability_zone"].(bool)
+	singleInstanceType, _ := tfMap["single_instance_type"].(bool)
 
-	var apiObjects []*ec2.FleetLaunchTemplateOverridesRequest
+	if fleetType != ec2.FleetTypeInstant {
+		var options []string
 
-	for _, tfMapRaw := range tfList {
-		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if len(capacityReservationOptions) > 0 {
+			options = append(options, "capacity_reservation_options")
+		}
 
-		if !ok {
-			continue
+		if minTargetCapacity > 0 {
+			options = append(options, "min_target_capacity")
 		}
 
-		apiObject := expandFleetLaunchTemplateOverridesRequest(tfMap)
+		if singleAvailabilityZone {
+			options = append(options, "single_availability_zone")
+		}
 
-		if apiObject == nil {
-			continue
+		if singleInstanceType {
+			options = append(options, "single_instance_type")
 		}
 
-		apiObjects = append(apiObjects, apiObject)
+		if len(options) > 0 {
+			return fmt.Errorf("%s can only be specified for fleets of type %s, not %s", strings.Join(options, ", "), ec2.FleetTypeInstant, fleetType)
+		}
 	}
 
-	return apiObjects
-}
+	if minTargetCapacity > 0 && !singleAvailabilityZone && !singleInstanceType {
+		return errors.New("min_target_capacity requires single_availability_zone or single_instance_type to be true")
+	}
+
+	return nil
+}
+
+// normalizeFleetAllocationStrategy returns the hyphenated spelling of an EC2 Fleet allocation strategy.
+// Depending on the API call, allocation strategies are accepted and returned in either a hyphenated
+// ("capacity-optimized") or a camel case ("capacityOptimized") spelling.
+func normalizeFleetAllocationStrategy(v string) string {
+	var sb strings.Builder
+
+	for _, r := range v {
+		if unicode.IsUpper(r) {
+			sb.WriteRune('-')
+			r = unicode.ToLower(r)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func fleetAllocationStrategiesEqual(a, b string) bool {
+	return normalizeFleetAllocationStrategy(a) == normalizeFleetAllocationStrategy(b)
+}
+
+// fleetAllocationStrategyValues returns both the hyphenated and camel case spellings of the specified allocation strategies.
+func fleetAllocationStrategyValues(values []string) []string {
+	var spellings []string
+
+	for _, v := range values {
+		v = normalizeFleetAllocationStrategy(v)
+		spellings = append(spellings, v)
+
+		if camel := fleetAllocationStrategyCamelCase(v); camel != v {
+			spellings = append(spellings, camel)
+		}
+	}
+
+	return spellings
+}
+
+func fleetAllocationStrategyCamelCase(v string) string {
+	parts := strings.Split(v, "-")
+
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// configuredFleetSpotAllocationStrategy returns the spot_options allocation_strategy set in the configuration.
+// The schema default is ignored so that fleets without spot_options aren't reported as using the default strategy.
+func configuredFleetSpotAllocationStrategy(rawConfig cty.Value) string {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return ""
+	}
+
+	v := rawConfig.GetAttr("spot_options")
+
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return ""
+	}
+
+	v = v.Index(cty.NumberIntVal(0))
+
+	if !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	v = v.GetAttr("allocation_strategy")
+
+	if !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+// fleetSpotAllocationStrategyWarnings returns a warning if the specified spot allocation strategy is deprecated.
+// The warning is returned from Create, as CustomizeDiff cannot return warnings and changing the strategy forces a new fleet.
+func fleetSpotAllocationStrategyWarnings(allocationStrategy string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if fleetAllocationStrategiesEqual(allocationStrategy, SpotAllocationStrategyLowestPrice) {
+		diags = sdkdiag.AppendWarningf(diags, "spot_options.0.allocation_strategy: %q is deprecated. AWS recommends %q, which launches Spot Instances from the lowest priced pools that have the most available capacity", allocationStrategy, ec2.SpotAllocationStrategyPriceCapacityOptimized)
+	}
+
+	return diags
+}
+
+func suppressEquivalentFleetAllocationStrategy(k, old, new string, d *schema.ResourceData) bool {
+	return fleetAllocationStrategiesEqual(old, new)
+}
+
+// instantFleetFulfillmentError returns an error if an instant fleet didn't fulfill its target capacity because instances failed to launch,
+// unless partial fulfillment is allowed and some capacity was fulfilled.
+func instantFleetFulfillmentError(apiObjects []*ec2.CreateFleetError, fulfilledCapacity, targetCapacity float64, allowPartialFulfillment bool) error {
+	err := CreateFleetErrors(apiObjects)
+
+	if err == nil || fulfilledCapacity >= targetCapacity {
+		return nil
+	}
+
+	if allowPartialFulfillment && fulfilledCapacity > 0 {
+		return nil
+	}
+
+	return err
+}
+
+// fleetInstanceRequirementsWarnings returns a warning for each launch template override, in the form accepted by
+// expandFleetLaunchTemplateConfigRequests, whose instance_requirements set both allowed_instance_types and instance_generations.
+// The warnings are returned from Create and Update, as CustomizeDiff cannot return warnings.
+func fleetInstanceRequirementsWarnings(tfList []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		overrides, _ := tfMap["override"].([]interface{})
+
+		for j, tfMapRaw := range overrides {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			v, ok := tfMap["instance_requirements"].([]interface{})
+
+			if !ok || len(v) == 0 || v[0] == nil {
+				continue
+			}
+
+			tfMap = v[0].(map[string]interface{})
+
+			if v, ok := tfMap["allowed_instance_types"].(*schema.Set); !ok || v.Len() == 0 {
+				continue
+			}
+
+			if v, ok := tfMap["instance_generations"].(*schema.Set); !ok || v.Len() == 0 {
+				continue
+			}
+
+			diags = sdkdiag.AppendWarningf(diags, "launch_template_config.%d.override.%d.instance_requirements: both allowed_instance_types and instance_generations are set. allowed_instance_types already constrains the instance generations, so instance_generations is redundant or, if it excludes the generation of every allowed instance type, leaves no matching instance types", i, j)
+		}
+	}
+
+	return diags
+}
+
+// hashStringCaseInsensitive hashes a string ignoring its case.
+// AWS may return instance type wildcards (e.g. "M5.*") in a different case than configured.
+func hashStringCaseInsensitive(v interface{}) int {
+	return create.StringHashcode(strings.ToLower(v.(string)))
+}
+
+func expandCapacityReservationOptionsRequest(tfMap map[string]interface{}) *ec2.CapacityReservationOptionsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CapacityReservationOptionsRequest{}
+
+	if v, ok := tfMap["usage_strategy"].(string); ok && v != "" {
+		apiObject.UsageStrategy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFleetLaunchTemplateConfigRequests(tfList []interface{}) []*ec2.FleetLaunchTemplateConfigRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ec2.FleetLaunchTemplateConfigRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandFleetLaunchTemplateConfigRequest(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFleetLaunchTemplateConfigRequest(tfMap map[string]interface{}) *ec2.FleetLaunchTemplateConfigRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.FleetLaunchTemplateConfigRequest{}
+
+	if v, ok := tfMap["launch_template_specification"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LaunchTemplateSpecification = expandFleetLaunchTemplateSpecificationRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["override"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Overrides = expandFleetLaunchTemplateOverridesRequests(v)
+	}
+
+	return apiObject
+}
+
+// expandFleetLaunchTemplateConfigRequestsFromConfig expands launch_template_config, sending only the
+// launch template identifier that is configured. Both launch_template_id and launch_template_name are
+// computed, so the planned value of the other one may be left over from a previously configured template.
+// An explicitly configured require_hibernate_support of false is also sent, as it can't be distinguished
+// from an unset value in the planned state.
+func expandFleetLaunchTemplateConfigRequestsFromConfig(d *schema.ResourceData) []*ec2.FleetLaunchTemplateConfigRequest {
+	apiObjects := expandFleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{}))
+
+	rawConfig := d.GetRawConfig()
+
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return apiObjects
+	}
+
+	configs := rawConfig.GetAttr("launch_template_config")
+
+	if configs.IsNull() || !configs.IsKnown() {
+		return apiObjects
+	}
+
+	for i, apiObject := range apiObjects {
+		if apiObject == nil || i >= configs.LengthInt() {
+			continue
+		}
+
+		config := configs.Index(cty.NumberIntVal(int64(i)))
+
+		expandFleetLaunchTemplateOverridesRequestsFromConfig(apiObject.Overrides, config.GetAttr("override"))
+
+		if apiObject.LaunchTemplateSpecification == nil {
+			continue
+		}
+
+		specifications := config.GetAttr("launch_template_specification")
+
+		if specifications.IsNull() || !specifications.IsKnown() || specifications.LengthInt() == 0 {
+			continue
+		}
+
+		specification := specifications.Index(cty.NumberIntVal(0))
+
+		if specification.GetAttr("launch_template_id").IsNull() {
+			apiObject.LaunchTemplateSpecification.LaunchTemplateId = nil
+		}
+
+		if specification.GetAttr("launch_template_name").IsNull() {
+			apiObject.LaunchTemplateSpecification.LaunchTemplateName = nil
+		}
+	}
+
+	return apiObjects
+}
+
+// expandFleetLaunchTemplateOverridesRequestsFromConfig sets require_hibernate_support on the expanded overrides
+// when it is explicitly configured as false.
+func expandFleetLaunchTemplateOverridesRequestsFromConfig(apiObjects []*ec2.FleetLaunchTemplateOverridesRequest, overrides cty.Value) {
+	if overrides.IsNull() || !overrides.IsKnown() {
+		return
+	}
+
+	for i, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.InstanceRequirements == nil || i >= overrides.LengthInt() {
+			continue
+		}
+
+		instanceRequirements := overrides.Index(cty.NumberIntVal(int64(i))).GetAttr("instance_requirements")
+
+		if instanceRequirements.IsNull() || !instanceRequirements.IsKnown() || instanceRequirements.LengthInt() == 0 {
+			continue
+		}
+
+		if v := instanceRequirements.Index(cty.NumberIntVal(0)).GetAttr("require_hibernate_support"); !v.IsNull() && v.IsKnown() && v.False() {
+			apiObject.InstanceRequirements.RequireHibernateSupport = aws.Bool(false)
+		}
+	}
+}
+
+func expandFleetLaunchTemplateSpecificationRequest(tfMap map[string]interface{}) *ec2.FleetLaunchTemplateSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.FleetLaunchTemplateSpecificationRequest{}
+
+	if v, ok := tfMap["launch_template_id"].(string); ok && v != "" {
+		apiObject.LaunchTemplateId = aws.String(v)
+	}
+
+	if v, ok := tfMap["launch_template_name"].(string); ok && v != "" {
+		apiObject.LaunchTemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFleetLaunchTemplateOverridesRequests(tfList []interface{}) []*ec2.FleetLaunchTemplateOverridesRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ec2.FleetLaunchTemplateOverridesRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandFleetLaunchTemplateOverridesRequest(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
 
 func expandFleetLaunchTemplateOverridesRequest(tfMap map[string]interface{}) *ec2.FleetLaunchTemplateOverridesRequest {
 	if tfMap == nil {
@@ -1141,7 +1734,7 @@ func expandSpotOptionsRequest(tfMap map[string]interface{}) *ec2.SpotOptionsRequ
 		apiObject.AllocationStrategy = aws.String(v)
 
 		// InvalidFleetConfig: InstancePoolsToUseCount option is only available with the lowestPrice allocation strategy.
-		if v == SpotAllocationStrategyLowestPrice {
+		if fleetAllocationStrategiesEqual(v, SpotAllocationStrategyLowestPrice) {
 			if v, ok := tfMap["instance_pools_to_use_count"].(int); ok {
 				apiObject.InstancePoolsToUseCount = aws.Int64(int64(v))
 			}
@@ -1152,7 +1745,7 @@ func expandSpotOptionsRequest(tfMap map[string]interface{}) *ec2.SpotOptionsRequ
 		apiObject.InstanceInterruptionBehavior = aws.String(v)
 	}
 
-	if v, ok := tfMap["maintenance_strategies"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := tfMap["maintenance_strategies"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.MaintenanceStrategies = expandFleetSpotMaintenanceStrategiesRequest(v[0].(map[string]interface{}))
 	}
 
@@ -1212,7 +1805,7 @@ func expandFleetSpotMaintenanceStrategiesRequest(tfMap map[string]interface{}) *
 
 	apiObject := &ec2.FleetSpotMaintenanceStrategiesRequest{}
 
-	if v, ok := tfMap["capacity_rebalance"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := tfMap["capacity_rebalance"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.CapacityRebalance = expandFleetSpotCapacityRebalanceRequest(v[0].(map[string]interface{}))
 	}
 
@@ -1230,7 +1823,7 @@ func expandFleetSpotCapacityRebalanceRequest(tfMap map[string]interface{}) *ec2.
 		apiObject.ReplacementStrategy = aws.String(v)
 	}
 
-	if v, ok := tfMap["termination_delay"].(int); ok {
+	if v, ok := tfMap["termination_delay"].(int); ok && v != 0 {
 		apiObject.TerminationDelay = aws.Int64(int64(v))
 	}
 
@@ -1307,6 +1900,50 @@ func flattenFleetInstances(apiObject *ec2.DescribeFleetsInstances) map[string]in
 	return tfMap
 }
 
+func flattenFleetError(apiObject *ec2.DescribeFleetError) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ErrorCode; v != nil {
+		tfMap["error_code"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ErrorMessage; v != nil {
+		tfMap["error_message"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LaunchTemplateAndOverrides; v != nil && v.Overrides != nil && v.Overrides.InstanceType != nil {
+		tfMap["instance_type"] = aws.StringValue(v.Overrides.InstanceType)
+	}
+
+	if v := apiObject.Lifecycle; v != nil {
+		tfMap["lifecycle"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFleetErrorSet(apiObjects []*ec2.DescribeFleetError) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenFleetError(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenFleetInstanceSet(apiObjects []*ec2.DescribeFleetsInstances) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -1325,6 +1962,178 @@ func flattenFleetInstanceSet(apiObjects []*ec2.DescribeFleetsInstances) []interf
 	return tfList
 }
 
+// flattenFleetActiveInstanceSet groups the active instances of a maintain or request fleet by instance type and lifecycle,
+// in the form of the instance sets DescribeFleets returns for instant fleets.
+func flattenFleetActiveInstanceSet(apiObjects []*ec2.ActiveInstance) []interface{} {
+	type key struct {
+		instanceType string
+		lifecycle    string
+	}
+
+	var keys []key
+	instanceIDs := map[key][]string{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || aws.StringValue(apiObject.InstanceId) == "" {
+			continue
+		}
+
+		k := key{
+			instanceType: aws.StringValue(apiObject.InstanceType),
+			lifecycle:    ec2.InstanceLifecycleOnDemand,
+		}
+
+		if aws.StringValue(apiObject.SpotInstanceRequestId) != "" {
+			k.lifecycle = ec2.InstanceLifecycleSpot
+		}
+
+		if _, ok := instanceIDs[k]; !ok {
+			keys = append(keys, k)
+		}
+
+		instanceIDs[k] = append(instanceIDs[k], aws.StringValue(apiObject.InstanceId))
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].instanceType != keys[j].instanceType {
+			return keys[i].instanceType < keys[j].instanceType
+		}
+
+		return keys[i].lifecycle < keys[j].lifecycle
+	})
+
+	var tfList []interface{}
+
+	for _, k := range keys {
+		v := instanceIDs[k]
+		sort.Strings(v)
+
+		tfList = append(tfList, map[string]interface{}{
+			"instance_ids":  v,
+			"instance_type": k.instanceType,
+			"lifecycle":     k.lifecycle,
+		})
+	}
+
+	return tfList
+}
+
+// flattenFleetActiveInstanceIDs returns the sorted IDs of the active instances of a maintain or request fleet.
+func flattenFleetActiveInstanceIDs(apiObjects []*ec2.ActiveInstance) []string {
+	var instanceIDs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if v := aws.StringValue(apiObject.InstanceId); v != "" {
+			instanceIDs = append(instanceIDs, v)
+		}
+	}
+
+	sort.Strings(instanceIDs)
+
+	return instanceIDs
+}
+
+// flattenFleetInstanceIDs returns the IDs of the instances across all of the fleet's instance sets.
+func flattenFleetInstanceIDs(apiObjects []*ec2.DescribeFleetsInstances) []string {
+	var instanceIDs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		instanceIDs = append(instanceIDs, aws.StringValueSlice(apiObject.InstanceIds)...)
+	}
+
+	return instanceIDs
+}
+
+// setFleetLaunchTemplateResolvedVersions sets the resolved_version of each flattened launch template specification in `tfList`
+// to the launch template version in use by the fleet. Numbered versions are used as is. "$Default" and "$Latest" are
+// resolved from the launch template versions the fleet reports in its instances and errors, which keep the version the
+// fleet launched with even if the launch template's default or latest version changes.
+func setFleetLaunchTemplateResolvedVersions(tfList []interface{}, apiObject *ec2.FleetData) {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["launch_template_specification"].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		spec, ok := v[0].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		version, _ := spec["version"].(string)
+
+		if _, err := strconv.Atoi(version); err == nil {
+			spec["resolved_version"] = version
+			continue
+		}
+
+		id, _ := spec["launch_template_id"].(string)
+		name, _ := spec["launch_template_name"].(string)
+
+		if v := findFleetLaunchTemplateVersion(apiObject, id, name); v != "" {
+			spec["resolved_version"] = v
+		}
+	}
+}
+
+// findFleetLaunchTemplateVersion returns the numbered version of the launch template with the specified ID or name
+// that the fleet reports in its instances or errors, or "" if the fleet doesn't report one.
+func findFleetLaunchTemplateVersion(apiObject *ec2.FleetData, id, name string) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	var launchTemplateAndOverrides []*ec2.LaunchTemplateAndOverridesResponse
+
+	for _, v := range apiObject.Instances {
+		if v != nil {
+			launchTemplateAndOverrides = append(launchTemplateAndOverrides, v.LaunchTemplateAndOverrides)
+		}
+	}
+
+	for _, v := range apiObject.Errors {
+		if v != nil {
+			launchTemplateAndOverrides = append(launchTemplateAndOverrides, v.LaunchTemplateAndOverrides)
+		}
+	}
+
+	for _, v := range launchTemplateAndOverrides {
+		if v == nil || v.LaunchTemplateSpecification == nil {
+			continue
+		}
+
+		spec := v.LaunchTemplateSpecification
+
+		if (id == "" || aws.StringValue(spec.LaunchTemplateId) != id) && (name == "" || aws.StringValue(spec.LaunchTemplateName) != name) {
+			continue
+		}
+
+		version := aws.StringValue(spec.Version)
+
+		if _, err := strconv.Atoi(version); err == nil {
+			return version
+		}
+	}
+
+	return ""
+}
+
 func flattenFleetLaunchTemplateConfigs(apiObjects []*ec2.FleetLaunchTemplateConfig) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -1343,6 +2152,9 @@ func flattenFleetLaunchTemplateConfigs(apiObjects []*ec2.FleetLaunchTemplateConf
 	return tfList
 }
 
+// flattenFleetLaunchTemplateConfig flattens a launch template configuration as returned by DescribeFleets.
+// The launch template may have been deleted since the fleet was created, in which case the specification
+// still refers to it by ID or is missing altogether; neither is looked up here.
 func flattenFleetLaunchTemplateConfig(apiObject *ec2.FleetLaunchTemplateConfig) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1350,8 +2162,8 @@ func flattenFleetLaunchTemplateConfig(apiObject *ec2.FleetLaunchTemplateConfig)
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.LaunchTemplateSpecification; v != nil {
-		tfMap["launch_template_specification"] = []interface{}{flattenFleetLaunchTemplateSpecificationForFleet(v)}
+	if v := flattenFleetLaunchTemplateSpecificationForFleet(apiObject.LaunchTemplateSpecification); len(v) > 0 {
+		tfMap["launch_template_specification"] = []interface{}{v}
 	}
 
 	if v := apiObject.Overrides; v != nil {
@@ -1420,6 +2232,154 @@ func flattenFleetLaunchTemplateOverrideses(apiObjects []*ec2.FleetLaunchTemplate
 	return tfList
 }
 
+// sortFleetLaunchTemplateConfigOverrides orders each launch template config's overrides to match the
+// order in the prior state, as the API doesn't necessarily return them in the order they were specified,
+// e.g. when several overrides differ only by subnet_id.
+// Overrides not found in the prior state are appended in the order returned by the API.
+func sortFleetLaunchTemplateConfigOverrides(old, new []interface{}) []interface{} {
+	for i, tfMapRaw := range new {
+		if i >= len(old) {
+			break
+		}
+
+		tfMapNew, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tfMapOld, ok := old[i].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		oldOverrides, _ := tfMapOld["override"].([]interface{})
+		newOverrides, _ := tfMapNew["override"].([]interface{})
+
+		if len(oldOverrides) == 0 || len(newOverrides) < 2 {
+			continue
+		}
+
+		used := make([]bool, len(newOverrides))
+		sorted := make([]interface{}, 0, len(newOverrides))
+
+		for _, tfMapRaw := range oldOverrides {
+			key := fleetLaunchTemplateOverrideKey(tfMapRaw)
+
+			for j, v := range newOverrides {
+				if !used[j] && fleetLaunchTemplateOverrideKey(v) == key {
+					used[j] = true
+					sorted = append(sorted, v)
+
+					break
+				}
+			}
+		}
+
+		for j, v := range newOverrides {
+			if !used[j] {
+				sorted = append(sorted, v)
+			}
+		}
+
+		tfMapNew["override"] = sorted
+	}
+
+	return new
+}
+
+// setFleetLaunchTemplateOverridePlacements sets the placement attributes of launch template overrides
+// that DescribeFleets doesn't return (all but group_name) from their prior values.
+func setFleetLaunchTemplateOverridePlacements(oldTfList, tfList []interface{}) {
+	for i, tfMapRaw := range tfList {
+		if i >= len(oldTfList) {
+			break
+		}
+
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		oldTfMap, ok := oldTfList[i].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		overrides, _ := tfMap["override"].([]interface{})
+		oldOverrides, _ := oldTfMap["override"].([]interface{})
+
+		for j, v := range overrides {
+			if j >= len(oldOverrides) {
+				break
+			}
+
+			override, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			oldPlacement := fleetLaunchTemplateOverridePlacement(oldOverrides[j])
+
+			if oldPlacement == nil {
+				continue
+			}
+
+			placement := fleetLaunchTemplateOverridePlacement(override)
+
+			if placement == nil {
+				placement = map[string]interface{}{}
+				override["placement"] = []interface{}{placement}
+			}
+
+			for _, k := range []string{"affinity", "availability_zone", "tenancy"} {
+				placement[k] = oldPlacement[k]
+			}
+		}
+	}
+}
+
+func fleetLaunchTemplateOverridePlacement(tfMapRaw interface{}) map[string]interface{} {
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	v, ok := tfMap["placement"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	placement, _ := v[0].(map[string]interface{})
+
+	return placement
+}
+
+// fleetLaunchTemplateOverrideKey returns a key identifying a launch template override by the attributes
+// that distinguish the capacity pools it applies to.
+func fleetLaunchTemplateOverrideKey(tfMapRaw interface{}) string {
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+
+	if !ok {
+		return ""
+	}
+
+	var parts []string
+
+	for _, k := range []string{"availability_zone", "image_id", "instance_type", "subnet_id"} {
+		v, _ := tfMap[k].(string)
+		parts = append(parts, v)
+	}
+
+	return strings.Join(parts, "|")
+}
+
 func flattenFleetLaunchTemplateOverrides(apiObject *ec2.FleetLaunchTemplateOverrides) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1447,8 +2407,8 @@ func flattenFleetLaunchTemplateOverrides(apiObject *ec2.FleetLaunchTemplateOverr
 		tfMap["max_price"] = aws.StringValue(v)
 	}
 
-	if v := apiObject.Placement; v != nil {
-		tfMap["placement"] = []interface{}{flattenPlacement(v)}
+	if v := flattenPlacement(apiObject.Placement); len(v) > 0 {
+		tfMap["placement"] = []interface{}{v}
 	}
 
 	if v := apiObject.Priority; v != nil {
@@ -1477,8 +2437,8 @@ func flattenOnDemandOptions(apiObject *ec2.OnDemandOptions) map[string]interface
 		tfMap["allocation_strategy"] = aws.StringValue(v)
 	}
 
-	if v := apiObject.CapacityReservationOptions; v != nil {
-		tfMap["capacity_reservation_options"] = []interface{}{flattenCapacityReservationsOptions(v)}
+	if v := flattenCapacityReservationsOptions(apiObject.CapacityReservationOptions); len(v) > 0 {
+		tfMap["capacity_reservation_options"] = []interface{}{v}
 	}
 
 	if v := apiObject.MaxTotalPrice; v != nil {
@@ -1537,8 +2497,9 @@ func flattenSpotOptions(apiObject *ec2.SpotOptions) map[string]interface{} {
 		tfMap["instance_pools_to_use_count"] = 1
 	}
 
-	if v := apiObject.MaintenanceStrategies; v != nil {
-		tfMap["maintenance_strategies"] = []interface{}{flattenFleetSpotMaintenanceStrategies(v)}
+	// The API can return an empty MaintenanceStrategies structure when none were configured.
+	if v := flattenFleetSpotMaintenanceStrategies(apiObject.MaintenanceStrategies); len(v) > 0 {
+		tfMap["maintenance_strategies"] = []interface{}{v}
 	}
 
 	return tfMap
@@ -1551,8 +2512,8 @@ func flattenFleetSpotMaintenanceStrategies(apiObject *ec2.FleetSpotMaintenanceSt
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.CapacityRebalance; v != nil {
-		tfMap["capacity_rebalance"] = []interface{}{flattenFleetSpotCapacityRebalance(v)}
+	if v := flattenFleetSpotCapacityRebalance(apiObject.CapacityRebalance); len(v) > 0 {
+		tfMap["capacity_rebalance"] = []interface{}{v}
 	}
 
 	return tfMap
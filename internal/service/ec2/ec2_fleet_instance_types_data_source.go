@@ -0,0 +1,155 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_ec2_fleet_instance_types")
+func DataSourceFleetInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFleetInstanceTypesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.ArchitectureType_Values(), false),
+				},
+			},
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// The launch template configurations are expanded as they are for an EC2 Fleet.
+			"launch_template_config": ResourceFleet().Schema["launch_template_config"],
+			"virtualization_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.VirtualizationType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFleetInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	architectureTypes := flex.ExpandStringSet(d.Get("architecture_types").(*schema.Set))
+	virtualizationTypes := flex.ExpandStringSet(d.Get("virtualization_types").(*schema.Set))
+	instanceTypes := make(map[string]struct{})
+
+	for _, config := range expandFleetLaunchTemplateConfigRequestsFromConfig(d) {
+		overrides := config.Overrides
+
+		// Without overrides, the fleet launches the instance type from the launch template.
+		if len(overrides) == 0 {
+			overrides = []*ec2.FleetLaunchTemplateOverridesRequest{{}}
+		}
+
+		for _, override := range overrides {
+			instanceType, instanceRequirements := override.InstanceType, override.InstanceRequirements
+
+			if instanceType == nil && instanceRequirements == nil {
+				data, err := findFleetLaunchTemplateData(ctx, conn, config.LaunchTemplateSpecification)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading EC2 Fleet Instance Types: %s", err)
+				}
+
+				instanceType = data.InstanceType
+
+				if v := data.InstanceRequirements; v != nil {
+					instanceRequirements = expandInstanceRequirementsRequest(flattenInstanceRequirements(v))
+				}
+			}
+
+			if v := aws.StringValue(instanceType); v != "" {
+				instanceTypes[v] = struct{}{}
+				continue
+			}
+
+			if instanceRequirements == nil {
+				continue
+			}
+
+			input := &ec2.GetInstanceTypesFromInstanceRequirementsInput{
+				ArchitectureTypes:    architectureTypes,
+				InstanceRequirements: instanceRequirements,
+				VirtualizationTypes:  virtualizationTypes,
+			}
+
+			output, err := FindInstanceTypesFromInstanceRequirements(ctx, conn, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 Fleet Instance Types: %s", err)
+			}
+
+			for _, v := range output {
+				instanceTypes[aws.StringValue(v.InstanceType)] = struct{}{}
+			}
+		}
+	}
+
+	var tfList []string
+
+	for v := range instanceTypes {
+		tfList = append(tfList, v)
+	}
+
+	sort.Strings(tfList)
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("instance_types", tfList)
+
+	return diags
+}
+
+// findFleetLaunchTemplateData returns the data of the launch template version used by an EC2 Fleet launch template configuration.
+func findFleetLaunchTemplateData(ctx context.Context, conn *ec2.EC2, apiObject *ec2.FleetLaunchTemplateSpecificationRequest) (*ec2.ResponseLaunchTemplateData, error) {
+	if apiObject == nil {
+		return nil, errors.New("launch_template_specification is required when an override sets neither instance_type nor instance_requirements")
+	}
+
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId:   apiObject.LaunchTemplateId,
+		LaunchTemplateName: apiObject.LaunchTemplateName,
+		Versions:           aws.StringSlice([]string{aws.StringValue(apiObject.Version)}),
+	}
+
+	output, err := FindLaunchTemplateVersion(ctx, conn, input)
+
+	if err != nil {
+		id := aws.StringValue(apiObject.LaunchTemplateId)
+		if id == "" {
+			id = aws.StringValue(apiObject.LaunchTemplateName)
+		}
+
+		return nil, fmt.Errorf("reading EC2 Launch Template (%s) version (%s): %w", id, aws.StringValue(apiObject.Version), err)
+	}
+
+	return output.LaunchTemplateData, nil
+}
@@ -0,0 +1,111 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2FleetInstanceTypesDataSource_instanceRequirements(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_fleet_instance_types.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetInstanceTypesDataSourceConfig_instanceRequirements(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "instance_types.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2FleetInstanceTypesDataSource_launchTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_fleet_instance_types.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetInstanceTypesDataSourceConfig_launchTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0", "t3.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.1", "t3.small"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetInstanceTypesDataSourceConfig_instanceRequirements(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), `
+data "aws_ec2_fleet_instance_types" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      instance_requirements {
+        memory_mib {
+          min = 500
+          max = 4000
+        }
+
+        vcpu_count {
+          min = 1
+          max = 2
+        }
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccFleetInstanceTypesDataSourceConfig_launchTemplate(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), `
+data "aws_ec2_fleet_instance_types" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_name = aws_launch_template.test.name
+      version              = "$Latest"
+    }
+  }
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      instance_type = "t3.small"
+    }
+  }
+}
+`)
+}
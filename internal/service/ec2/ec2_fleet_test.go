@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -97,6 +102,96 @@ func TestAccEC2Fleet_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2Fleet_alreadyDeleted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					testAccCheckFleetDeleteOutOfBand(ctx, &fleet1),
+					// Destroying the fleet from its now stale state must succeed.
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_deletedRunning(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_type_instant(rName, "maintain", true, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					testAccCheckFleetFulfilled(ctx, &fleet1, 1),
+					testAccCheckFleetDeleteOutOfBandWithoutTerminatingInstances(ctx, &fleet1),
+					// Destroying the fleet, now deleted_running, must terminate its instances.
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceFleet(), resourceName),
+					testAccCheckFleetInstancesTerminated(ctx, &fleet1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_launchTemplateDeleted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceLaunchTemplate(), launchTemplateResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The fleet still refers to the deleted launch template.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
@@ -181,6 +276,34 @@ func TestAccEC2Fleet_excessCapacityTerminationPolicy(t *testing.T) {
 	})
 }
 
+func TestAccEC2Fleet_excessCapacityTerminationPolicyDefault(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "termination"),
+					resource.TestCheckResourceAttr(resourceName, "type", "maintain"),
+				),
+			},
+			{
+				Config:   testAccFleetConfig_basic(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
@@ -202,6 +325,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateID(
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_id", launchTemplateResourceName1, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_name", launchTemplateResourceName1, "name"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.version", launchTemplateResourceName1, "latest_version"),
 				),
 			},
@@ -219,6 +343,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateID(
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_id", launchTemplateResourceName2, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_name", launchTemplateResourceName2, "name"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.version", launchTemplateResourceName2, "latest_version"),
 				),
 			},
@@ -246,6 +371,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateNam
 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_id", launchTemplateResourceName1, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_name", launchTemplateResourceName1, "name"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.version", launchTemplateResourceName1, "latest_version"),
 				),
@@ -263,6 +389,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateNam
 					testAccCheckFleetNotRecreated(&fleet1, &fleet2),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_id", launchTemplateResourceName2, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.launch_template_name", launchTemplateResourceName2, "name"),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.launch_template_specification.0.version", launchTemplateResourceName2, "latest_version"),
 				),
@@ -322,6 +449,66 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_version(t *testin
 	})
 }
 
+func TestAccEC2Fleet_LaunchTemplateOverride_tooMany(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_launchTemplateOverrideCount(rName, 301),
+				ExpectError: regexp.MustCompile(`No more than 300 "override" blocks are allowed|attribute supports 300 item maximum`),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_resolvedVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	launchTemplateResourceName := "aws_launch_template.test"
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateDefaultVersion(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(launchTemplateResourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.0.version", "$Default"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.0.resolved_version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				// Changing the launch template's default version doesn't change the version used by the fleet.
+				Config: testAccFleetConfig_launchTemplateDefaultVersion(rName, "t3.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetNotRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(launchTemplateResourceName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.launch_template_specification.0.resolved_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_availabilityZone(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
@@ -364,32 +551,82 @@ func TestAccEC2Fleet_LaunchTemplateOverride_availabilityZone(t *testing.T) {
 	})
 }
 
-// Pending AWS to provide this attribute back in the `Describe` call.
-// func TestAccEC2Fleet_LaunchTemplateOverride_imageId(t *testing.T) {
-// 	ctx := acctest.Context(t)
-// 	var fleet1 ec2.FleetData
-// 	awsAmiDataSourceName := "data.aws_ami.amz2"
-// 	resourceName := "aws_ec2_fleet.test"
-// 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-// 	resource.ParallelTest(t, resource.TestCase{
-// 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckFleet(ctx, t) },
-// 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-// 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-// 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccFleetConfig_launchTemplateOverrideImageId(rName),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
-// 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
-// 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
-// 					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.image_id", awsAmiDataSourceName, "id"),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
+func TestAccEC2Fleet_LaunchTemplateOverride_availabilityZoneLocalZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	availabilityZonesDataSourceName := "data.aws_availability_zones.available"
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckFleet(ctx, t)
+			testAccPreCheckLocalZoneAvailable(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideAvailabilityZoneLocalZone(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.availability_zone", availabilityZonesDataSourceName, "names.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_LaunchTemplateOverride_imageId(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideImageId(rName, "amzn-ami-minimal-hvm-ebs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.image_id", "data.aws_ami.amzn-ami-minimal-hvm-ebs", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideImageId(rName, "amzn2-ami-minimal-hvm-ebs-x86_64"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetNotRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.image_id", "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", "id"),
+				),
+			},
+		},
+	})
+}
 
 func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_memoryMiBAndVCPUCount(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -560,10 +797,11 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorCoun
 	})
 }
 
-func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManufacturers(t *testing.T) {
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorCountMaxZero(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
@@ -572,8 +810,10 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
-					`accelerator_manufacturers = ["amd"]
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName,
+					`accelerator_count {
+                       max = 0
+                     }
                      memory_mib {
                        min = 500
                      }
@@ -586,8 +826,9 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
 
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.#", "1"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "amd"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_count.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_count.0.max", "0"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_count.0.min", "0"),
 				),
 			},
 			{
@@ -596,9 +837,24 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"terminate_instances"},
 			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManufacturers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
-					`accelerator_manufacturers = ["amazon-web-services", "amd", "nvidia", "xilinx"]
+					`accelerator_manufacturers = ["amd"]
                      memory_mib {
                        min = 500
                      }
@@ -611,11 +867,8 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
 
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.#", "4"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "amazon-web-services"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.#", "1"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "amd"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "nvidia"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "xilinx"),
 				),
 			},
 			{
@@ -624,10 +877,38 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"terminate_instances"},
 			},
-		},
-	})
-}
-
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
+					`accelerator_manufacturers = ["amazon-web-services", "amd", "nvidia", "xilinx"]
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.#", "4"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "amazon-web-services"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "amd"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "nvidia"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.accelerator_manufacturers.*", "xilinx"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorNames(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet ec2.FleetData
@@ -931,6 +1212,47 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_allowedInstance
 	})
 }
 
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_allowedInstanceTypesMixedCase(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceRequirements := `allowed_instance_types = ["M5.*", "m6I*", "C5.Large"]
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName, instanceRequirements),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.allowed_instance_types.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config:   testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName, instanceRequirements),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_bareMetal(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet ec2.FleetData
@@ -1403,6 +1725,247 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_instanceGenerat
 	})
 }
 
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_allowedInstanceTypesAndInstanceGenerations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Setting both arguments only results in a warning.
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
+					`allowed_instance_types = ["m5.*"]
+                     instance_generations   = ["current"]
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.allowed_instance_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.allowed_instance_types.*", "m5.*"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.instance_generations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.instance_generations.*", "current"),
+				),
+			},
+		},
+	})
+}
+
+func TestHashStringCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	set := schema.NewSet(tfec2.HashStringCaseInsensitive, []interface{}{"m5.*", "M5.*", "m6i.large", "M6I.Large"})
+
+	if got, expected := set.Len(), 2; got != expected {
+		t.Errorf("got %d elements, expected %d", got, expected)
+	}
+
+	if tfec2.HashStringCaseInsensitive("c5.*") == tfec2.HashStringCaseInsensitive("c6.*") {
+		t.Error("expected different instance types to hash differently")
+	}
+}
+
+func TestFleetInstanceRequirementsWarnings(t *testing.T) {
+	t.Parallel()
+
+	override := func(allowedInstanceTypes, instanceGenerations []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"instance_requirements": []interface{}{
+				map[string]interface{}{
+					"allowed_instance_types": schema.NewSet(schema.HashString, allowedInstanceTypes),
+					"instance_generations":   schema.NewSet(schema.HashString, instanceGenerations),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		input            []interface{}
+		expectedWarnings []string
+	}{
+		{
+			name: "no instance requirements",
+			input: []interface{}{
+				map[string]interface{}{
+					"override": []interface{}{
+						map[string]interface{}{"instance_type": "t3.micro"},
+					},
+				},
+			},
+		},
+		{
+			name: "allowed_instance_types only",
+			input: []interface{}{
+				map[string]interface{}{
+					"override": []interface{}{override([]interface{}{"m5.*"}, nil)},
+				},
+			},
+		},
+		{
+			name: "instance_generations only",
+			input: []interface{}{
+				map[string]interface{}{
+					"override": []interface{}{override(nil, []interface{}{"current"})},
+				},
+			},
+		},
+		{
+			name: "both",
+			input: []interface{}{
+				map[string]interface{}{
+					"override": []interface{}{override([]interface{}{"m5.*"}, nil)},
+				},
+				map[string]interface{}{
+					"override": []interface{}{
+						override(nil, []interface{}{"current"}),
+						override([]interface{}{"m5.*"}, []interface{}{"current"}),
+					},
+				},
+			},
+			expectedWarnings: []string{"launch_template_config.1.override.1.instance_requirements: both allowed_instance_types and instance_generations are set"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfec2.FleetInstanceRequirementsWarnings(testCase.input)
+
+			if got, expected := len(diags), len(testCase.expectedWarnings); got != expected {
+				t.Fatalf("got %d diagnostics, expected %d", got, expected)
+			}
+
+			for i, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Errorf("diagnostic %d: got severity %v, expected warning", i, d.Severity)
+				}
+
+				if !strings.HasPrefix(d.Summary, testCase.expectedWarnings[i]) {
+					t.Errorf("diagnostic %d: got summary %q, expected prefix %q", i, d.Summary, testCase.expectedWarnings[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFleetSpotAllocationStrategyWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input           string
+		expectedWarning bool
+	}{
+		{input: ""},
+		{input: "capacity-optimized"},
+		{input: "diversified"},
+		{input: "price-capacity-optimized"},
+		{input: "priceCapacityOptimized"},
+		{input: "lowestPrice", expectedWarning: true},
+		{input: "lowest-price", expectedWarning: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.input, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfec2.FleetSpotAllocationStrategyWarnings(testCase.input)
+
+			if got, expected := len(diags) > 0, testCase.expectedWarning; got != expected {
+				t.Fatalf("got warning %t, expected %t", got, expected)
+			}
+
+			for i, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Errorf("diagnostic %d: got severity %v, expected warning", i, d.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestConfiguredFleetSpotAllocationStrategy(t *testing.T) {
+	t.Parallel()
+
+	spotOptionsType := cty.List(cty.Object(map[string]cty.Type{
+		"allocation_strategy": cty.String,
+	}))
+
+	testCases := []struct {
+		name     string
+		input    cty.Value
+		expected string
+	}{
+		{
+			name:  "null config",
+			input: cty.NullVal(cty.Object(map[string]cty.Type{"spot_options": spotOptionsType})),
+		},
+		{
+			name: "no spot_options",
+			input: cty.ObjectVal(map[string]cty.Value{
+				"spot_options": cty.ListValEmpty(spotOptionsType.ElementType()),
+			}),
+		},
+		{
+			name: "allocation_strategy not set",
+			input: cty.ObjectVal(map[string]cty.Value{
+				"spot_options": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"allocation_strategy": cty.NullVal(cty.String),
+					}),
+				}),
+			}),
+		},
+		{
+			name: "allocation_strategy unknown",
+			input: cty.ObjectVal(map[string]cty.Value{
+				"spot_options": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"allocation_strategy": cty.UnknownVal(cty.String),
+					}),
+				}),
+			}),
+		},
+		{
+			name: "allocation_strategy set",
+			input: cty.ObjectVal(map[string]cty.Value{
+				"spot_options": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"allocation_strategy": cty.StringVal("lowestPrice"),
+					}),
+				}),
+			}),
+			expected: "lowestPrice",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfec2.ConfiguredFleetSpotAllocationStrategy(testCase.input); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_localStorage(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet ec2.FleetData
@@ -1952,6 +2515,67 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_requireHibernat
 	})
 }
 
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_requireHibernateSupportFalse(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName,
+					`require_hibernate_support = true
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.require_hibernate_support", "true"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName,
+					`require_hibernate_support = false
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.require_hibernate_support", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName,
+					`require_hibernate_support = false
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_spotMaxPricePercentageOverLowestPrice(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet ec2.FleetData
@@ -1991,6 +2615,56 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_spotMaxPricePer
 	})
 }
 
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_fractionalPrecision(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceRequirements := `memory_gib_per_vcpu {
+                       min = 0.50
+                       max = 9.50
+                     }
+                     total_local_storage_gb {
+                       min = 1.1
+                       max = 20.25
+                     }
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName, instanceRequirements),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.memory_gib_per_vcpu.0.min", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.memory_gib_per_vcpu.0.max", "9.5"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.total_local_storage_gb.0.min", "1.1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.total_local_storage_gb.0.max", "20.25"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config:   testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName, instanceRequirements),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_totalLocalStorageGB(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet ec2.FleetData
@@ -2130,7 +2804,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceType(t *testing.T) {
 	})
 }
 
-func TestAccEC2Fleet_LaunchTemplateOverride_maxPrice(t *testing.T) {
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceTypeToInstanceRequirements(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
@@ -2143,19 +2817,97 @@ func TestAccEC2Fleet_LaunchTemplateOverride_maxPrice(t *testing.T) {
 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFleetConfig_launchTemplateOverrideMaxPrice(rName, "1.01"),
+				Config: testAccFleetConfig_launchTemplateOverrideType(rName, "maintain", `instance_type = "t3.small"`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.max_price", "1.01"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "0"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				Config: testAccFleetConfig_launchTemplateOverrideType(rName, "maintain",
+					`instance_requirements {
+                       memory_mib {
+                         min = 500
+                       }
+                       vcpu_count {
+                         min = 1
+                       }
+                     }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetNotRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.memory_mib.0.min", "500"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_requirements.0.vcpu_count.0.min", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_LaunchTemplateOverride_instanceTypeRequestType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideType(rName, "request", `instance_type = "t3.small"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_type", "t3.small"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideType(rName, "request", `instance_type = "t3.medium"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.instance_type", "t3.medium"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_LaunchTemplateOverride_maxPrice(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideMaxPrice(rName, "1.01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.max_price", "1.01"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideMaxPrice(rName, "1.02"),
@@ -2171,32 +2923,45 @@ func TestAccEC2Fleet_LaunchTemplateOverride_maxPrice(t *testing.T) {
 	})
 }
 
-// Pending AWS to provide this attribute back in the `Describe` call.
-// func TestAccEC2Fleet_LaunchTemplateOverride_placement(t *testing.T) {
-// 	ctx := acctest.Context(t)
-// 	var fleet1 ec2.FleetData
-// 	resourceName := "aws_ec2_fleet.test"
-// 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-// 	resource.ParallelTest(t, resource.TestCase{
-// 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckFleet(ctx, t) },
-// 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-// 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-// 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccFleetConfig_launchTemplateOverridePlacement(rName),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
-// 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
-// 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
-// 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.placement", "1"),
-// 					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.placement.group_name", rName),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
+func TestAccEC2Fleet_LaunchTemplateOverride_placement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverridePlacement(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.placement.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.placement.0.availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.placement.0.group_name", "aws_placement_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.0.placement.0.tenancy", "default"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// DescribeFleets only returns the placement group name.
+				ImportStateVerifyIgnore: []string{
+					"allow_partial_fulfillment",
+					"launch_template_config.0.override.0.placement.0.availability_zone",
+					"launch_template_config.0.override.0.placement.0.tenancy",
+					"terminate_instances",
+				},
+			},
+		},
+	})
+}
 
 func TestAccEC2Fleet_LaunchTemplateOverride_priority(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -2325,6 +3090,37 @@ func TestAccEC2Fleet_LaunchTemplateOverride_subnetID(t *testing.T) {
 	})
 }
 
+func TestAccEC2Fleet_LaunchTemplateOverride_multipleSubnetIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_launchTemplateOverrideMultipleSubnetIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.0.subnet_id", "aws_subnet.test.2", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.1.subnet_id", "aws_subnet.test.0", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_config.0.override.2.subnet_id", "aws_subnet.test.1", "id"),
+				),
+			},
+			{
+				Config:   testAccFleetConfig_launchTemplateOverrideMultipleSubnetIDs(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_LaunchTemplateOverride_weightedCapacity(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
@@ -2448,35 +3244,52 @@ func TestAccEC2Fleet_OnDemandOptions_allocationStrategy(t *testing.T) {
 	})
 }
 
-// Pending AWS to provide this attribute back in the `Describe` call.
-// func TestAccEC2Fleet_OnDemandOptions_CapacityReservationOptions(t *testing.T) {
-// 	ctx := acctest.Context(t)
-// 	var fleet1 ec2.FleetData
-// 	resourceName := "aws_ec2_fleet.test"
-// 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-// 	resource.ParallelTest(t, resource.TestCase{
-// 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
-// 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-// 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-// 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccFleetConfig_onDemandOptionsCapacityReservationOptions(rName, "use-capacity-reservations-first"),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
-// 					resource.TestCheckResourceAttr(resourceName, "on_demand_options.#", "1"),
-// 					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.capacity_reservation_options.#", "1"),
-// 					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.capacity_reservation_options.0.usage_strategy", "use-capacity-reservations-first"),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
+func TestAccEC2Fleet_OnDemandOptions_CapacityReservationOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_onDemandOptionsCapacityReservationOptions(rName, "use-capacity-reservations-first", "instant"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.capacity_reservation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.capacity_reservation_options.0.usage_strategy", "use-capacity-reservations-first"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_OnDemandOptions_capacityReservationOptionsInvalidType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_onDemandOptionsCapacityReservationOptions(rName, "use-capacity-reservations-first", "maintain"),
+				ExpectError: regexp.MustCompile(`capacity_reservation_options can only be specified for fleets of type instant, not maintain`),
+			},
+		},
+	})
+}
 
 func TestAccEC2Fleet_OnDemandOptions_MaxTotalPrice(t *testing.T) {
 	ctx := acctest.Context(t)
-	var fleet1 ec2.FleetData
+	var fleet1, fleet2 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -2494,6 +3307,22 @@ func TestAccEC2Fleet_OnDemandOptions_MaxTotalPrice(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.max_total_price", "1.0"),
 				),
 			},
+			{
+				// The post-apply plan verifies that the value returned by AWS does not produce a difference.
+				Config: testAccFleetConfig_onDemandOptionsMaxTotalPrice(rName, "2.000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.#", "1"),
+					resource.TestCheckResourceAttrWith(resourceName, "on_demand_options.0.max_total_price", func(value string) error {
+						if v, err := strconv.ParseFloat(value, 64); err != nil || v != 2 {
+							return fmt.Errorf("on_demand_options.0.max_total_price: expected a value equivalent to 2, got %q", value)
+						}
+
+						return nil
+					}),
+				),
+			},
 		},
 	})
 }
@@ -2570,6 +3399,101 @@ func TestAccEC2Fleet_OnDemandOptions_SingleInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Fleet_OnDemandOptions_invalidType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_onDemandOptionsInvalidType(rName),
+				ExpectError: regexp.MustCompile(`min_target_capacity, single_instance_type can only be specified for fleets of type instant, not maintain`),
+			},
+		},
+	})
+}
+
+func TestValidFleetOnDemandOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         map[string]interface{}
+		fleetType     string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:      "maintain without instant options",
+			input:     map[string]interface{}{"allocation_strategy": "lowestPrice"},
+			fleetType: ec2.FleetTypeMaintain,
+		},
+		{
+			name:      "instant with all options",
+			input:     map[string]interface{}{"min_target_capacity": 1, "single_availability_zone": true, "single_instance_type": true},
+			fleetType: ec2.FleetTypeInstant,
+		},
+		{
+			name:          "maintain with single_instance_type",
+			input:         map[string]interface{}{"single_instance_type": true},
+			fleetType:     ec2.FleetTypeMaintain,
+			expectedError: regexp.MustCompile(`^single_instance_type can only be specified for fleets of type instant, not maintain$`),
+		},
+		{
+			name:          "request with all options",
+			input:         map[string]interface{}{"min_target_capacity": 1, "single_availability_zone": true, "single_instance_type": true},
+			fleetType:     ec2.FleetTypeRequest,
+			expectedError: regexp.MustCompile(`^min_target_capacity, single_availability_zone, single_instance_type can only be specified for fleets of type instant, not request$`),
+		},
+		{
+			name:      "instant with capacity_reservation_options",
+			input:     map[string]interface{}{"capacity_reservation_options": []interface{}{map[string]interface{}{"usage_strategy": ec2.FleetCapacityReservationUsageStrategyUseCapacityReservationsFirst}}},
+			fleetType: ec2.FleetTypeInstant,
+		},
+		{
+			name:          "maintain with capacity_reservation_options",
+			input:         map[string]interface{}{"capacity_reservation_options": []interface{}{map[string]interface{}{"usage_strategy": ec2.FleetCapacityReservationUsageStrategyUseCapacityReservationsFirst}}},
+			fleetType:     ec2.FleetTypeMaintain,
+			expectedError: regexp.MustCompile(`^capacity_reservation_options can only be specified for fleets of type instant, not maintain$`),
+		},
+		{
+			name:          "instant min_target_capacity only",
+			input:         map[string]interface{}{"min_target_capacity": 1},
+			fleetType:     ec2.FleetTypeInstant,
+			expectedError: regexp.MustCompile(`^min_target_capacity requires single_availability_zone or single_instance_type to be true$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfec2.ValidFleetOnDemandOptions(testCase.input, testCase.fleetType)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccEC2Fleet_replaceUnhealthyInstances(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
@@ -2596,6 +3520,7 @@ func TestAccEC2Fleet_replaceUnhealthyInstances(t *testing.T) {
 				ImportStateVerifyIgnore: []string{"terminate_instances"},
 			},
 			{
+				// ModifyFleet doesn't support ReplaceUnhealthyInstances.
 				Config: testAccFleetConfig_replaceUnhealthyInstances(rName, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFleetExists(ctx, resourceName, &fleet2),
@@ -2609,7 +3534,7 @@ func TestAccEC2Fleet_replaceUnhealthyInstances(t *testing.T) {
 
 func TestAccEC2Fleet_SpotOptions_allocationStrategy(t *testing.T) {
 	ctx := acctest.Context(t)
-	var fleet1, fleet2 ec2.FleetData
+	var fleet1, fleet2, fleet3 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -2642,19 +3567,29 @@ func TestAccEC2Fleet_SpotOptions_allocationStrategy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", "lowestPrice"),
 				),
 			},
+			// ModifyFleet can't change the spot allocation strategy.
+			{
+				Config: testAccFleetConfig_spotOptionsAllocationStrategy(rName, "price-capacity-optimized"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet3),
+					testAccCheckFleetRecreated(&fleet2, &fleet3),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", "price-capacity-optimized"),
+				),
+			},
+			{
+				Config:   testAccFleetConfig_spotOptionsAllocationStrategy(rName, "priceCapacityOptimized"),
+				PlanOnly: true,
+			},
 		},
 	})
 }
 
-func TestAccEC2Fleet_SpotOptions_capacityRebalance(t *testing.T) {
+func TestAccEC2Fleet_SpotOptions_allocationStrategyHyphenated(t *testing.T) {
 	ctx := acctest.Context(t)
-	var fleet1 ec2.FleetData
-
+	var fleet1, fleet2 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	allocationStrategy := "diversified"
-	replacementStrategy := "launch-before-terminate"
-	terminationDelay := "120"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
@@ -2663,44 +3598,36 @@ func TestAccEC2Fleet_SpotOptions_capacityRebalance(t *testing.T) {
 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFleetConfig_spotOptionsCapacityRebalance(rName, allocationStrategy, replacementStrategy, terminationDelay),
+				Config: testAccFleetConfig_spotOptionsAllocationStrategy(rName, "capacity-optimized"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
 					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", allocationStrategy),
-					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", replacementStrategy),
-					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0.termination_delay", terminationDelay),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				Config:   testAccFleetConfig_spotOptionsAllocationStrategy(rName, "capacity-optimized"),
+				PlanOnly: true,
 			},
-		},
-	})
-}
-
-func TestAccEC2Fleet_capacityRebalanceInvalidType(t *testing.T) {
-	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckFleetDestroy(ctx),
-		Steps: []resource.TestStep{
 			{
-				Config:      testAccFleetConfig_invalidTypeForCapacityRebalance(rName),
-				ExpectError: regexp.MustCompile(`Capacity Rebalance maintenance strategies can only be specified for fleets of type maintain`),
+				Config:   testAccFleetConfig_spotOptionsAllocationStrategy(rName, "capacityOptimized"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccFleetConfig_spotOptionsAllocationStrategy(rName, "lowest-price"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+				),
+			},
+			{
+				Config:   testAccFleetConfig_spotOptionsAllocationStrategy(rName, "lowestPrice"),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2Fleet_SpotOptions_instanceInterruptionBehavior(t *testing.T) {
+func TestAccEC2Fleet_SpotOptions_removed(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1, fleet2 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
@@ -2713,21 +3640,153 @@ func TestAccEC2Fleet_SpotOptions_instanceInterruptionBehavior(t *testing.T) {
 		CheckDestroy:             testAccCheckFleetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFleetConfig_spotOptionsInstanceInterruptionBehavior(rName, "stop"),
+				Config: testAccFleetConfig_spotOptionsAllocationStrategy(rName, "diversified"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
 					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "spot_options.0.instance_interruption_behavior", "stop"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", "diversified"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
-			},
-			{
-				Config: testAccFleetConfig_spotOptionsInstanceInterruptionBehavior(rName, "terminate"),
+				Config: testAccFleetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", "lowestPrice"),
+				),
+			},
+			{
+				Config:   testAccFleetConfig_basic(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_SpotOptions_capacityRebalance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allocationStrategy := "diversified"
+	replacementStrategy := "launch-before-terminate"
+	terminationDelay := "120"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_spotOptionsCapacityRebalance(rName, allocationStrategy, replacementStrategy, terminationDelay),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", allocationStrategy),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", replacementStrategy),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0.termination_delay", terminationDelay),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_SpotOptions_capacityRebalanceTerminationDelay(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allocationStrategy := "diversified"
+	replacementStrategy := "launch-before-terminate"
+	terminationDelay := "600"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_spotOptionsCapacityRebalance(rName, allocationStrategy, replacementStrategy, terminationDelay),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", replacementStrategy),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.maintenance_strategies.0.capacity_rebalance.0.termination_delay", terminationDelay),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config:   testAccFleetConfig_spotOptionsCapacityRebalance(rName, allocationStrategy, replacementStrategy, terminationDelay),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_capacityRebalanceInvalidType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_invalidTypeForCapacityRebalance(rName),
+				ExpectError: regexp.MustCompile(`Capacity Rebalance maintenance strategies can only be specified for fleets of type maintain`),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_SpotOptions_instanceInterruptionBehavior(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_spotOptionsInstanceInterruptionBehavior(rName, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.instance_interruption_behavior", "stop"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config: testAccFleetConfig_spotOptionsInstanceInterruptionBehavior(rName, "terminate"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFleetExists(ctx, resourceName, &fleet2),
 					testAccCheckFleetRecreated(&fleet1, &fleet2),
@@ -2897,6 +3956,10 @@ func TestAccEC2Fleet_TargetCapacitySpecification_totalTargetCapacity(t *testing.
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"terminate_instances"},
 			},
+			{
+				Config:   testAccFleetConfig_targetCapacitySpecificationTotalTargetCapacity(rName, 1),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccFleetConfig_targetCapacitySpecificationTotalTargetCapacity(rName, 2),
 				Check: resource.ComposeTestCheckFunc(
@@ -2910,11 +3973,79 @@ func TestAccEC2Fleet_TargetCapacitySpecification_totalTargetCapacity(t *testing.
 	})
 }
 
-func TestAccEC2Fleet_TargetCapacitySpecification_targetCapacityUnitType(t *testing.T) {
+func TestAccEC2Fleet_TargetCapacitySpecification_onDemandSpotMix(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_targetCapacitySpecificationOnDemandSpotMix(rName, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.on_demand_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.spot_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_TargetCapacitySpecification_derivedOnDemandTargetCapacity(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1 ec2.FleetData
 	resourceName := "aws_ec2_fleet.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// on_demand_target_capacity is derived from total_target_capacity and spot_target_capacity.
+				Config: testAccFleetConfig_targetCapacitySpecificationOnDemandSpotMix(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.spot_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config:   testAccFleetConfig_targetCapacitySpecificationOnDemandSpotMix(rName, 0, 1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_TargetCapacitySpecification_targetCapacityUnitType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	targetCapacityUnitType := "vcpu"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -2937,6 +4068,15 @@ func TestAccEC2Fleet_TargetCapacitySpecification_targetCapacityUnitType(t *testi
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"terminate_instances"},
 			},
+			{
+				// ModifyFleet can't change the target capacity unit type.
+				Config: testAccFleetConfig_targetCapacitySpecificationTargetCapacityUnitType(rName, 1, "memory-mib"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.target_capacity_unit_type", "memory-mib"),
+				),
+			},
 		},
 	})
 }
@@ -2975,92 +4115,628 @@ func TestAccEC2Fleet_terminateInstancesWithExpiration(t *testing.T) {
 				),
 			},
 		},
-	})
+	})
+}
+
+func TestAccEC2Fleet_type(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	excessCapacityTerminationPolicy := "termination"
+	fleetType := "maintain"
+	terminateInstances := false
+	validFrom := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_type(rName, fleetType, excessCapacityTerminationPolicy, terminateInstances),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "type", fleetType),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			// A future start time prevents the request from being fulfilled during the test.
+			{
+				Config: testAccFleetConfig_typeRequestValidFrom(rName, validFrom),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "type", "request"),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "valid_from", validFrom),
+					resource.TestCheckResourceAttrWith(resourceName, "fleet_state", func(value string) error {
+						if want := aws.StringValue(fleet2.FleetState); value != want {
+							return fmt.Errorf("fleet_state: expected %q, got %q", want, value)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_type_maintainInstances(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	fleetType := "maintain"
+	totalTargetCapacity := "1"
+	terminateInstances := true
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_type_instant(rName, fleetType, terminateInstances, totalTargetCapacity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					testAccCheckFleetFulfilled(ctx, &fleet1, 1),
+					resource.TestCheckResourceAttr(resourceName, "type", fleetType),
+				),
+			},
+			{
+				// Refresh now that the fleet has launched its instance.
+				Config: testAccFleetConfig_type_instant(rName, fleetType, terminateInstances, totalTargetCapacity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_ids.#", totalTargetCapacity),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_type", "t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.lifecycle", "spot"),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", totalTargetCapacity),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_ids.0", resourceName, "fleet_instance_set.0.instance_ids.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config:   testAccFleetConfig_type_instant(rName, fleetType, terminateInstances, totalTargetCapacity),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_type_instant(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	fleetType := "instant"
+	totalTargetCapacity := "2"
+	terminateInstances := true
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_type_instant(rName, fleetType, terminateInstances, totalTargetCapacity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "type", fleetType),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_ids.#", totalTargetCapacity),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_ids.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.lifecycle"),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", totalTargetCapacity),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_ids.0", resourceName, "fleet_instance_set.0.instance_ids.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			},
+			{
+				Config:   testAccFleetConfig_type_instant(rName, fleetType, terminateInstances, totalTargetCapacity),
+				PlanOnly: true,
+			},
+			// This configuration will fulfill immediately, skip until ValidFrom is implemented
+			// {
+			// 	Config: testAccFleetConfig_type(rName, "request"),
+			// 	Check: resource.ComposeTestCheckFunc(
+			// 		testAccCheckFleetExists(resourceName, &fleet2),
+			// 		testAccCheckFleetRecreated(&fleet1, &fleet2),
+			// 		resource.TestCheckResourceAttr(resourceName, "type", "request"),
+			// 	),
+			// },
+		},
+	})
+}
+
+func TestAccEC2Fleet_type_instantRecreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2, fleet3 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_type_instant(rName, "instant", true, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "type", "instant"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_type_instant(rName, "maintain", true, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "type", "maintain"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_type_instant(rName, "instant", true, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet3),
+					testAccCheckFleetRecreated(&fleet2, &fleet3),
+					resource.TestCheckResourceAttr(resourceName, "type", "instant"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_ids.#", "1"),
+					resource.TestCheckResourceAttrWith(resourceName, "fleet_instance_set.0.instance_ids.0", func(value string) error {
+						if len(fleet3.Instances) == 0 || len(fleet3.Instances[0].InstanceIds) == 0 {
+							return fmt.Errorf("EC2 Fleet (%s) has no instances", aws.StringValue(fleet3.FleetId))
+						}
+
+						if want := aws.StringValue(fleet3.Instances[0].InstanceIds[0]); value != want {
+							return fmt.Errorf("fleet_instance_set.0.instance_ids.0: expected %q, got %q", want, value)
+						}
+
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_ids.0", resourceName, "fleet_instance_set.0.instance_ids.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Fleet_type_instantAllowPartialFulfillment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1 ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_typeInstantAllowPartialFulfillment(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "allow_partial_fulfillment", "true"),
+					resource.TestCheckResourceAttr(resourceName, "type", "instant"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_ids.0"),
+				),
+			},
+			{
+				// Updating the argument doesn't modify the fleet.
+				Config: testAccFleetConfig_typeInstantAllowPartialFulfillment(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "allow_partial_fulfillment", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeFleetAllocationStrategy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "", expected: ""},
+		{input: "diversified", expected: "diversified"},
+		{input: "prioritized", expected: "prioritized"},
+		{input: "lowestPrice", expected: "lowest-price"},
+		{input: "lowest-price", expected: "lowest-price"},
+		{input: "capacityOptimized", expected: "capacity-optimized"},
+		{input: "capacity-optimized", expected: "capacity-optimized"},
+		{input: "capacityOptimizedPrioritized", expected: "capacity-optimized-prioritized"},
+		{input: "capacity-optimized-prioritized", expected: "capacity-optimized-prioritized"},
+		{input: "priceCapacityOptimized", expected: "price-capacity-optimized"},
+		{input: "price-capacity-optimized", expected: "price-capacity-optimized"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfec2.NormalizeFleetAllocationStrategy(testCase.input); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestInstantFleetFulfillmentError(t *testing.T) {
+	t.Parallel()
+
+	launchErrors := []*ec2.CreateFleetError{
+		{
+			ErrorCode:    aws.String("InsufficientInstanceCapacity"),
+			ErrorMessage: aws.String("We currently do not have sufficient capacity in the Availability Zone you requested."),
+			LaunchTemplateAndOverrides: &ec2.LaunchTemplateAndOverridesResponse{
+				Overrides: &ec2.FleetLaunchTemplateOverrides{
+					InstanceType: aws.String("t3.micro"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		errors                  []*ec2.CreateFleetError
+		fulfilledCapacity       float64
+		targetCapacity          float64
+		allowPartialFulfillment bool
+		expectedError           *regexp.Regexp
+	}{
+		{
+			name:              "fulfilled",
+			fulfilledCapacity: 4,
+			targetCapacity:    4,
+		},
+		{
+			name:              "fulfilled with errors",
+			errors:            launchErrors,
+			fulfilledCapacity: 4,
+			targetCapacity:    4,
+		},
+		{
+			name:              "partially fulfilled",
+			errors:            launchErrors,
+			fulfilledCapacity: 2,
+			targetCapacity:    4,
+			expectedError:     regexp.MustCompile(`t3.micro: InsufficientInstanceCapacity`),
+		},
+		{
+			name:                    "partially fulfilled allowed",
+			errors:                  launchErrors,
+			fulfilledCapacity:       2,
+			targetCapacity:          4,
+			allowPartialFulfillment: true,
+		},
+		{
+			name:                    "unfulfilled",
+			errors:                  launchErrors,
+			targetCapacity:          4,
+			allowPartialFulfillment: true,
+			expectedError:           regexp.MustCompile(`t3.micro: InsufficientInstanceCapacity`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfec2.InstantFleetFulfillmentError(testCase.errors, testCase.fulfilledCapacity, testCase.targetCapacity, testCase.allowPartialFulfillment)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSortFleetLaunchTemplateConfigOverrides(t *testing.T) {
+	t.Parallel()
+
+	override := func(subnetID string) map[string]interface{} {
+		return map[string]interface{}{"subnet_id": subnetID}
+	}
+
+	old := []interface{}{
+		map[string]interface{}{"override": []interface{}{override("subnet-3"), override("subnet-1"), override("subnet-2")}},
+	}
+	new := []interface{}{
+		map[string]interface{}{"override": []interface{}{override("subnet-4"), override("subnet-1"), override("subnet-2"), override("subnet-3")}},
+	}
+
+	got := tfec2.SortFleetLaunchTemplateConfigOverrides(old, new)
+	expected := []interface{}{
+		map[string]interface{}{"override": []interface{}{override("subnet-3"), override("subnet-1"), override("subnet-2"), override("subnet-4")}},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestSetFleetLaunchTemplateOverridePlacements(t *testing.T) {
+	t.Parallel()
+
+	old := []interface{}{
+		map[string]interface{}{
+			"override": []interface{}{
+				map[string]interface{}{
+					"placement": []interface{}{
+						map[string]interface{}{
+							"affinity":          "",
+							"availability_zone": "us-west-2a", //lintignore:AWSAT003
+							"group_name":        "test",
+							"tenancy":           ec2.TenancyDedicated,
+						},
+					},
+				},
+				map[string]interface{}{
+					"placement": []interface{}{
+						map[string]interface{}{
+							"affinity":          "",
+							"availability_zone": "us-west-2b", //lintignore:AWSAT003
+							"group_name":        "",
+							"tenancy":           "",
+						},
+					},
+				},
+				map[string]interface{}{"instance_type": "t3.micro"},
+			},
+		},
+	}
+	new := []interface{}{
+		map[string]interface{}{
+			"override": []interface{}{
+				map[string]interface{}{
+					"placement": []interface{}{
+						map[string]interface{}{"group_name": "test"},
+					},
+				},
+				map[string]interface{}{},
+				map[string]interface{}{"instance_type": "t3.micro"},
+			},
+		},
+	}
+
+	tfec2.SetFleetLaunchTemplateOverridePlacements(old, new)
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"override": []interface{}{
+				map[string]interface{}{
+					"placement": []interface{}{
+						map[string]interface{}{
+							"affinity":          "",
+							"availability_zone": "us-west-2a", //lintignore:AWSAT003
+							"group_name":        "test",
+							"tenancy":           ec2.TenancyDedicated,
+						},
+					},
+				},
+				map[string]interface{}{
+					"placement": []interface{}{
+						map[string]interface{}{
+							"affinity":          "",
+							"availability_zone": "us-west-2b", //lintignore:AWSAT003
+							"tenancy":           "",
+						},
+					},
+				},
+				map[string]interface{}{"instance_type": "t3.micro"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(new, expected) {
+		t.Errorf("got %v, expected %v", new, expected)
+	}
+}
+
+func TestFlattenFleetLaunchTemplateConfigs(t *testing.T) {
+	t.Parallel()
+
+	// The launch template of a fleet may have been deleted since the fleet was created.
+	apiObjects := []*ec2.FleetLaunchTemplateConfig{
+		nil,
+		{
+			LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecification{
+				LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+				Version:          aws.String("1"),
+			},
+		},
+		{
+			LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecification{},
+			Overrides: []*ec2.FleetLaunchTemplateOverrides{
+				nil,
+				{InstanceType: aws.String("t3.micro")},
+			},
+		},
+		{},
+	}
+
+	got := tfec2.FlattenFleetLaunchTemplateConfigs(apiObjects)
+	expected := []interface{}{
+		map[string]interface{}{
+			"launch_template_specification": []interface{}{
+				map[string]interface{}{
+					"launch_template_id": "lt-0123456789abcdef0",
+					"version":            "1",
+				},
+			},
+		},
+		map[string]interface{}{
+			"override": []interface{}{
+				map[string]interface{}{"instance_type": "t3.micro"},
+			},
+		},
+		map[string]interface{}{},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestFlattenFleetActiveInstanceSet(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*ec2.ActiveInstance{
+		{InstanceId: aws.String("i-00000000000000003"), InstanceType: aws.String("t3.micro"), SpotInstanceRequestId: aws.String("sir-00000003")},
+		nil,
+		{InstanceId: aws.String("i-00000000000000002"), InstanceType: aws.String("t3.small")},
+		{InstanceId: aws.String("i-00000000000000001"), InstanceType: aws.String("t3.micro"), SpotInstanceRequestId: aws.String("sir-00000001")},
+		{InstanceId: aws.String("i-00000000000000004"), InstanceType: aws.String("t3.micro")},
+		{InstanceType: aws.String("t3.micro")},
+	}
+
+	got := tfec2.FlattenFleetActiveInstanceSet(apiObjects)
+	expected := []interface{}{
+		map[string]interface{}{
+			"instance_ids":  []string{"i-00000000000000004"},
+			"instance_type": "t3.micro",
+			"lifecycle":     ec2.InstanceLifecycleOnDemand,
+		},
+		map[string]interface{}{
+			"instance_ids":  []string{"i-00000000000000001", "i-00000000000000003"},
+			"instance_type": "t3.micro",
+			"lifecycle":     ec2.InstanceLifecycleSpot,
+		},
+		map[string]interface{}{
+			"instance_ids":  []string{"i-00000000000000002"},
+			"instance_type": "t3.small",
+			"lifecycle":     ec2.InstanceLifecycleOnDemand,
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if got := tfec2.FlattenFleetActiveInstanceSet(nil); got != nil {
+		t.Errorf("got %v, expected nil", got)
+	}
 }
 
-func TestAccEC2Fleet_type(t *testing.T) {
-	ctx := acctest.Context(t)
-	var fleet1 ec2.FleetData
-	resourceName := "aws_ec2_fleet.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	excessCapacityTerminationPolicy := "termination"
-	fleetType := "maintain"
-	terminateInstances := false
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckFleetDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccFleetConfig_type(rName, fleetType, excessCapacityTerminationPolicy, terminateInstances),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckFleetExists(ctx, resourceName, &fleet1),
-					resource.TestCheckResourceAttr(resourceName, "type", fleetType),
-				),
+func TestFlattenSpotOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		APIObject *ec2.SpotOptions
+		Expected  map[string]interface{}
+	}{
+		{
+			TestName: "empty maintenance strategies",
+			APIObject: &ec2.SpotOptions{
+				AllocationStrategy:    aws.String(ec2.SpotAllocationStrategyLowestPrice),
+				MaintenanceStrategies: &ec2.FleetSpotMaintenanceStrategies{},
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			Expected: map[string]interface{}{
+				"allocation_strategy": ec2.SpotAllocationStrategyLowestPrice,
 			},
-			// This configuration will fulfill immediately, skip until ValidFrom is implemented
-			// {
-			// 	Config: testAccFleetConfig_type(rName, "request"),
-			// 	Check: resource.ComposeTestCheckFunc(
-			// 		testAccCheckFleetExists(resourceName, &fleet2),
-			// 		testAccCheckFleetRecreated(&fleet1, &fleet2),
-			// 		resource.TestCheckResourceAttr(resourceName, "type", "request"),
-			// 	),
-			// },
 		},
-	})
-}
-
-func TestAccEC2Fleet_type_instant(t *testing.T) {
-	ctx := acctest.Context(t)
-	var fleet1 ec2.FleetData
-	resourceName := "aws_ec2_fleet.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	fleetType := "instant"
-	totalTargetCapacity := "2"
-	terminateInstances := true
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckFleetDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccFleetConfig_type_instant(rName, fleetType, terminateInstances, totalTargetCapacity),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckFleetExists(ctx, resourceName, &fleet1),
-					resource.TestCheckResourceAttr(resourceName, "type", fleetType),
-					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_ids.#", totalTargetCapacity),
-					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_ids.0"),
-					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_type"),
-					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.lifecycle"),
-				),
+		{
+			TestName: "empty capacity rebalance",
+			APIObject: &ec2.SpotOptions{
+				AllocationStrategy: aws.String(ec2.SpotAllocationStrategyLowestPrice),
+				MaintenanceStrategies: &ec2.FleetSpotMaintenanceStrategies{
+					CapacityRebalance: &ec2.FleetSpotCapacityRebalance{},
+				},
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+			Expected: map[string]interface{}{
+				"allocation_strategy": ec2.SpotAllocationStrategyLowestPrice,
 			},
-			// This configuration will fulfill immediately, skip until ValidFrom is implemented
-			// {
-			// 	Config: testAccFleetConfig_type(rName, "request"),
-			// 	Check: resource.ComposeTestCheckFunc(
-			// 		testAccCheckFleetExists(resourceName, &fleet2),
-			// 		testAccCheckFleetRecreated(&fleet1, &fleet2),
-			// 		resource.TestCheckResourceAttr(resourceName, "type", "request"),
-			// 	),
-			// },
 		},
-	})
+		{
+			TestName: "capacity rebalance with termination delay",
+			APIObject: &ec2.SpotOptions{
+				AllocationStrategy: aws.String(ec2.SpotAllocationStrategyLowestPrice),
+				MaintenanceStrategies: &ec2.FleetSpotMaintenanceStrategies{
+					CapacityRebalance: &ec2.FleetSpotCapacityRebalance{
+						ReplacementStrategy: aws.String(ec2.FleetReplacementStrategyLaunchBeforeTerminate),
+						TerminationDelay:    aws.Int64(600),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"allocation_strategy": ec2.SpotAllocationStrategyLowestPrice,
+				"maintenance_strategies": []interface{}{
+					map[string]interface{}{
+						"capacity_rebalance": []interface{}{
+							map[string]interface{}{
+								"replacement_strategy": ec2.FleetReplacementStrategyLaunchBeforeTerminate,
+								"termination_delay":    int64(600),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.FlattenSpotOptions(testCase.APIObject)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
 }
 
 // Test for the bug described in https://github.com/hashicorp/terraform-provider-aws/issues/6777
@@ -3232,6 +4908,73 @@ func testAccCheckFleetDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckFleetDeleteOutOfBand(ctx context.Context, v *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+		id := aws.StringValue(v.FleetId)
+
+		output, err := conn.DeleteFleetsWithContext(ctx, &ec2.DeleteFleetsInput{
+			FleetIds:           aws.StringSlice([]string{id}),
+			TerminateInstances: aws.Bool(true),
+		})
+
+		if err == nil && output != nil {
+			err = tfec2.DeleteFleetsError(output.UnsuccessfulFleetDeletions)
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EC2 Fleet (%s): %w", id, err)
+		}
+
+		return tfec2.WaitFleetDeleted(ctx, conn, id, []string{ec2.FleetStateCodeActive, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating}, []string{ec2.FleetStateCodeDeleted}, 15*time.Minute, 0)
+	}
+}
+
+func testAccCheckFleetDeleteOutOfBandWithoutTerminatingInstances(ctx context.Context, v *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+		id := aws.StringValue(v.FleetId)
+
+		output, err := conn.DeleteFleetsWithContext(ctx, &ec2.DeleteFleetsInput{
+			FleetIds:           aws.StringSlice([]string{id}),
+			TerminateInstances: aws.Bool(false),
+		})
+
+		if err == nil && output != nil {
+			err = tfec2.DeleteFleetsError(output.UnsuccessfulFleetDeletions)
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EC2 Fleet (%s): %w", id, err)
+		}
+
+		return tfec2.WaitFleetDeleted(ctx, conn, id, []string{ec2.FleetStateCodeActive}, []string{ec2.FleetStateCodeDeletedRunning}, 15*time.Minute, 0)
+	}
+}
+
+// testAccCheckFleetInstancesTerminated checks that the fleet has no active instances left.
+func testAccCheckFleetInstancesTerminated(ctx context.Context, v *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindFleetInstancesByID(ctx, conn, aws.StringValue(v.FleetId))
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) > 0 {
+			return fmt.Errorf("EC2 Fleet (%s) still has %d active instances", aws.StringValue(v.FleetId), len(output))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFleetNotRecreated(i, j *ec2.FleetData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if !aws.TimeValue(i.CreateTime).Equal(aws.TimeValue(j.CreateTime)) {
@@ -3252,6 +4995,23 @@ func testAccCheckFleetRecreated(i, j *ec2.FleetData) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckFleetFulfilled waits for the fleet to have at least the specified number of active instances.
+func testAccCheckFleetFulfilled(ctx context.Context, v *ec2.FleetData, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		return tfresource.WaitUntil(ctx, 10*time.Minute, func() (bool, error) {
+			output, err := tfec2.FindFleetInstancesByID(ctx, conn, aws.StringValue(v.FleetId))
+
+			if err != nil {
+				return false, err
+			}
+
+			return len(output) >= count, nil
+		}, tfresource.WaitOpts{PollInterval: 15 * time.Second})
+	}
+}
+
 func testAccPreCheckFleet(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
@@ -3455,25 +5215,145 @@ resource "aws_ec2_fleet" "test" {
 `, rName, launchTemplateResourceName))
 }
 
-func testAccFleetConfig_launchTemplateName(rName, launchTemplateResourceName string) string {
-	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
-resource "aws_launch_template" "test1" {
-  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = "t3.micro"
-  name          = "%[1]s1"
+func testAccFleetConfig_launchTemplateName(rName, launchTemplateResourceName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
+resource "aws_launch_template" "test1" {
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.micro"
+  name          = "%[1]s1"
+}
+
+resource "aws_launch_template" "test2" {
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.micro"
+  name          = "%[1]s2"
+}
+
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_name = %[2]s.name
+      version              = %[2]s.latest_version
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 0
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, launchTemplateResourceName))
+}
+
+func testAccFleetConfig_launchTemplateVersion(rName, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = %[2]q
+  name          = %[1]q
+}
+
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 0
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccFleetConfig_launchTemplateDefaultVersion(rName, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  image_id               = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type          = %[2]q
+  name                   = %[1]q
+  update_default_version = true
+}
+
+resource "aws_ec2_fleet" "test" {
+  type = "instant"
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = "$Default"
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 1
+  }
+
+  terminate_instances = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccFleetConfig_launchTemplateOverrideCount(rName string, count int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    dynamic "override" {
+      for_each = range(%[2]d)
+
+      content {
+        priority = override.value
+      }
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 0
+  }
+
+  tags = {
+    Name = %[1]q
+  }
 }
-
-resource "aws_launch_template" "test2" {
-  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = "t3.micro"
-  name          = "%[1]s2"
+`, rName, count))
 }
 
+func testAccFleetConfig_launchTemplateOverrideAvailabilityZone(rName string, availabilityZoneIndex int) string {
+	return acctest.ConfigCompose(
+		testAccFleetConfig_BaseLaunchTemplate(rName),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
   launch_template_config {
     launch_template_specification {
-      launch_template_name = %[2]s.name
-      version              = %[2]s.latest_version
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      availability_zone = data.aws_availability_zones.available.names[%[2]d]
     }
   }
 
@@ -3486,15 +5366,25 @@ resource "aws_ec2_fleet" "test" {
     Name = %[1]q
   }
 }
-`, rName, launchTemplateResourceName))
+`, rName, availabilityZoneIndex))
 }
 
-func testAccFleetConfig_launchTemplateVersion(rName, instanceType string) string {
-	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
-resource "aws_launch_template" "test" {
-  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
-  instance_type = %[2]q
-  name          = %[1]q
+func testAccFleetConfig_launchTemplateOverrideAvailabilityZoneLocalZone(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFleetConfig_BaseLaunchTemplate(rName),
+		fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "zone-type"
+    values = ["local-zone"]
+  }
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opted-in"]
+  }
 }
 
 resource "aws_ec2_fleet" "test" {
@@ -3503,6 +5393,10 @@ resource "aws_ec2_fleet" "test" {
       launch_template_id = aws_launch_template.test.id
       version            = aws_launch_template.test.latest_version
     }
+
+    override {
+      availability_zone = data.aws_availability_zones.available.names[0]
+    }
   }
 
   target_capacity_specification {
@@ -3514,13 +5408,13 @@ resource "aws_ec2_fleet" "test" {
     Name = %[1]q
   }
 }
-`, rName, instanceType))
+`, rName))
 }
 
-func testAccFleetConfig_launchTemplateOverrideAvailabilityZone(rName string, availabilityZoneIndex int) string {
+func testAccFleetConfig_launchTemplateOverrideImageId(rName, amiDataSourceName string) string {
 	return acctest.ConfigCompose(
 		testAccFleetConfig_BaseLaunchTemplate(rName),
-		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
 		fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
   launch_template_config {
@@ -3530,7 +5424,7 @@ resource "aws_ec2_fleet" "test" {
     }
 
     override {
-      availability_zone = data.aws_availability_zones.available.names[%[2]d]
+      image_id = data.aws_ami.%[2]s.id
     }
   }
 
@@ -3543,49 +5437,9 @@ resource "aws_ec2_fleet" "test" {
     Name = %[1]q
   }
 }
-`, rName, availabilityZoneIndex))
+`, rName, amiDataSourceName))
 }
 
-// Pending AWS to provide this attribute back in the `Describe` call.
-// func testAccFleetConfig_launchTemplateOverrideImageId(rName string) string {
-// 	return acctest.ConfigCompose(
-// 		testAccFleetConfig_BaseLaunchTemplate(rName),
-// 		acctest.ConfigAvailableAZsNoOptIn(),
-// 		fmt.Sprintf(`
-// resource "aws_ec2_fleet" "test" {
-//   launch_template_config {
-//     launch_template_specification {
-//       launch_template_id = aws_launch_template.test.id
-//       version            = aws_launch_template.test.latest_version
-//     }
-
-//     override {
-//       image_id = data.aws_ami.amz2.id
-//     }
-//   }
-
-//   target_capacity_specification {
-//     default_target_capacity_type = "spot"
-//     total_target_capacity        = 0
-//   }
-
-//   tags = {
-//     Name = %[1]q
-//   }
-// }
-
-// data "aws_ami" "amz2" {
-// 	most_recent = true
-
-// 	filter {
-// 	  name   = "name"
-// 	  values = ["amzn2-ami-hvm-*-x86_64-ebs"]
-// 	}
-// 	owners = ["amazon"]
-// }
-// `, rName))
-// }
-
 func testAccFleetConfig_launchTemplateOverrideInstanceRequirements(rName, instanceRequirements string) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -3640,6 +5494,36 @@ resource "aws_ec2_fleet" "test" {
 `, rName, instanceType))
 }
 
+func testAccFleetConfig_launchTemplateOverrideType(rName, fleetType, override string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  type = %[2]q
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      %[3]s
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    total_target_capacity        = 0
+  }
+
+  terminate_instances = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, fleetType, override))
+}
+
 func testAccFleetConfig_launchTemplateOverrideMaxPrice(rName, maxPrice string) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -3666,42 +5550,45 @@ resource "aws_ec2_fleet" "test" {
 `, rName, maxPrice))
 }
 
-// Pending AWS to provide this attribute back in the `Describe` call.
-// func testAccFleetConfig_launchTemplateOverridePlacement(rName string) string {
-// 	return acctest.ConfigCompose(
-// 		testAccFleetConfig_BaseLaunchTemplate(rName),
-// 		acctest.ConfigAvailableAZsNoOptIn(),
-// 		fmt.Sprintf(`
-// resource "aws_ec2_fleet" "test" {
-//   launch_template_config {
-//     launch_template_specification {
-//       launch_template_id = aws_launch_template.test.id
-//       version            = aws_launch_template.test.latest_version
-//     }
-
-//     override {
-// 		placement {
-// 			group_name = aws_launch_template.test.name
-// 		}
-//     }
-//   }
-
-//   target_capacity_specification {
-//     default_target_capacity_type = "spot"
-//     total_target_capacity        = 0
-//   }
-
-//   tags = {
-//     Name = %[1]q
-//   }
-// }
-
-// resource "aws_placement_group" "test" {
-// 	name     = %[1]q
-// 	strategy = "cluster"
-//   }
-// `, rName))
-// }
+func testAccFleetConfig_launchTemplateOverridePlacement(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFleetConfig_BaseLaunchTemplate(rName),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name     = %[1]q
+  strategy = "cluster"
+}
+
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      instance_type = "c5.large"
+
+      placement {
+        availability_zone = data.aws_availability_zones.available.names[0]
+        group_name        = aws_placement_group.test.name
+        tenancy           = "default"
+      }
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 0
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
 
 func testAccFleetConfig_launchTemplateOverridePriority(rName string, priority int) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
@@ -3787,6 +5674,40 @@ resource "aws_ec2_fleet" "test" {
 `, rName, subnetIndex))
 }
 
+func testAccFleetConfig_launchTemplateOverrideMultipleSubnetIDs(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), acctest.ConfigVPCWithSubnets(rName, 3), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+
+    override {
+      subnet_id = aws_subnet.test[2].id
+    }
+
+    override {
+      subnet_id = aws_subnet.test[0].id
+    }
+
+    override {
+      subnet_id = aws_subnet.test[1].id
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 0
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccFleetConfig_launchTemplateOverrideWeightedCapacity(rName string, weightedCapacity int) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -3871,36 +5792,35 @@ resource "aws_ec2_fleet" "test" {
 `, rName, allocationStrategy))
 }
 
-// Pending AWS to provide this attribute back in the `Describe` call.
-// func testAccFleetConfig_onDemandOptionsCapacityReservationOptions(rName, usageStrategy string) string {
-// 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
-// resource "aws_ec2_fleet" "test" {
-//   launch_template_config {
-//     launch_template_specification {
-//       launch_template_id = aws_launch_template.test.id
-//       version            = aws_launch_template.test.latest_version
-//     }
-//   }
-
-//   on_demand_options {
-//     capacity_reservation_options {
-//       usage_strategy = %[2]q
-//     }
-//   }
-
-//   target_capacity_specification {
-//     default_target_capacity_type = "on-demand"
-//     total_target_capacity        = 0
-//   }
-//   terminate_instances = true
-//   type = "instant"
-
-//   tags = {
-//     Name = %[1]q
-//   }
-// }
-// `, rName, usageStrategy))
-// }
+func testAccFleetConfig_onDemandOptionsCapacityReservationOptions(rName, usageStrategy, fleetType string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  on_demand_options {
+    capacity_reservation_options {
+      usage_strategy = %[2]q
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    total_target_capacity        = 0
+  }
+  terminate_instances = true
+  type                = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, usageStrategy, fleetType))
+}
 
 func testAccFleetConfig_onDemandOptionsMaxTotalPrice(rName, maxTotalPrice string) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
@@ -4016,6 +5936,35 @@ resource "aws_ec2_fleet" "test" {
 `, rName, singleInstanceType))
 }
 
+func testAccFleetConfig_onDemandOptionsInvalidType(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  on_demand_options {
+    min_target_capacity  = 1
+    single_instance_type = true
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    total_target_capacity        = 0
+  }
+
+  type = "maintain"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccFleetConfig_replaceUnhealthyInstances(rName string, replaceUnhealthyInstances bool) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -4274,6 +6223,32 @@ resource "aws_ec2_fleet" "test" {
 `, rName, totalTargetCapacity))
 }
 
+func testAccFleetConfig_targetCapacitySpecificationOnDemandSpotMix(rName string, onDemandTargetCapacity, spotTargetCapacity int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  terminate_instances = true
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    on_demand_target_capacity    = %[2]d == 0 ? null : %[2]d
+    spot_target_capacity         = %[3]d
+    total_target_capacity        = 2
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, onDemandTargetCapacity, spotTargetCapacity))
+}
+
 func testAccFleetConfig_targetCapacitySpecificationTargetCapacityUnitType(rName string, totalTargetCapacity int, targetCapacityUnitType string) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -4335,6 +6310,32 @@ resource "aws_ec2_fleet" "test" {
 `, rName, terminateInstancesWithExpiration))
 }
 
+func testAccFleetConfig_typeRequestValidFrom(rName, validFrom string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  type = "request"
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 0
+  }
+
+  valid_from = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, validFrom))
+}
+
 func testAccFleetConfig_type_instant(rName, fleetType string, terminateInstance bool, totalTargetCapacity string) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -4361,6 +6362,33 @@ resource "aws_ec2_fleet" "test" {
 `, rName, fleetType, terminateInstance, totalTargetCapacity))
 }
 
+func testAccFleetConfig_typeInstantAllowPartialFulfillment(rName string, allowPartialFulfillment bool) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  type                      = "instant"
+  allow_partial_fulfillment = %[2]t
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = 2
+  }
+
+  terminate_instances = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, allowPartialFulfillment))
+}
+
 func testAccFleetConfig_type(rName, fleetType string, excessCapacityTerminationPolicy string, terminateInstance bool) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
@@ -1561,288 +1561,6 @@ func expandLaunchTemplateInstanceMarketOptionsRequest(tfMap map[string]interface
 	return apiObject
 }
 
-func expandInstanceRequirementsRequest(tfMap map[string]interface{}) *ec2.InstanceRequirementsRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.InstanceRequirementsRequest{}
-
-	if v, ok := tfMap["accelerator_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.AcceleratorCount = expandAcceleratorCountRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["accelerator_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AcceleratorManufacturers = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["accelerator_names"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AcceleratorNames = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["accelerator_total_memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.AcceleratorTotalMemoryMiB = expandAcceleratorTotalMemoryMiBRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.AllowedInstanceTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["bare_metal"].(string); ok && v != "" {
-		apiObject.BareMetal = aws.String(v)
-	}
-
-	if v, ok := tfMap["baseline_ebs_bandwidth_mbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.BaselineEbsBandwidthMbps = expandBaselineEBSBandwidthMbpsRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["burstable_performance"].(string); ok && v != "" {
-		apiObject.BurstablePerformance = aws.String(v)
-	}
-
-	if v, ok := tfMap["cpu_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.CpuManufacturers = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["instance_generations"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.InstanceGenerations = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["local_storage"].(string); ok && v != "" {
-		apiObject.LocalStorage = aws.String(v)
-	}
-
-	if v, ok := tfMap["local_storage_types"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.LocalStorageTypes = flex.ExpandStringSet(v)
-	}
-
-	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.MemoryGiBPerVCpu = expandMemoryGiBPerVCPURequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.MemoryMiB = expandMemoryMiBRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["network_bandwidth_gbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.NetworkBandwidthGbps = expandNetworkBandwidthGbpsRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.NetworkInterfaceCount = expandNetworkInterfaceCountRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["on_demand_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
-		apiObject.OnDemandMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["require_hibernate_support"].(bool); ok && v {
-		apiObject.RequireHibernateSupport = aws.Bool(v)
-	}
-
-	if v, ok := tfMap["spot_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
-		apiObject.SpotMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["total_local_storage_gb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.TotalLocalStorageGB = expandTotalLocalStorageGBRequest(v[0].(map[string]interface{}))
-	}
-
-	if v, ok := tfMap["vcpu_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.VCpuCount = expandVCPUCountRangeRequest(v[0].(map[string]interface{}))
-	}
-
-	return apiObject
-}
-
-func expandAcceleratorCountRequest(tfMap map[string]interface{}) *ec2.AcceleratorCountRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.AcceleratorCountRequest{}
-
-	var min int
-	if v, ok := tfMap["min"].(int); ok {
-		min = v
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["max"].(int); ok && v >= min {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandAcceleratorTotalMemoryMiBRequest(tfMap map[string]interface{}) *ec2.AcceleratorTotalMemoryMiBRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.AcceleratorTotalMemoryMiBRequest{}
-
-	var min int
-	if v, ok := tfMap["min"].(int); ok {
-		min = v
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["max"].(int); ok && v >= min {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandBaselineEBSBandwidthMbpsRequest(tfMap map[string]interface{}) *ec2.BaselineEbsBandwidthMbpsRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.BaselineEbsBandwidthMbpsRequest{}
-
-	var min int
-	if v, ok := tfMap["min"].(int); ok {
-		min = v
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["max"].(int); ok && v >= min {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandMemoryGiBPerVCPURequest(tfMap map[string]interface{}) *ec2.MemoryGiBPerVCpuRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.MemoryGiBPerVCpuRequest{}
-
-	var min float64
-	if v, ok := tfMap["min"].(float64); ok {
-		min = v
-		apiObject.Min = aws.Float64(v)
-	}
-
-	if v, ok := tfMap["max"].(float64); ok && v >= min {
-		apiObject.Max = aws.Float64(v)
-	}
-
-	return apiObject
-}
-
-func expandMemoryMiBRequest(tfMap map[string]interface{}) *ec2.MemoryMiBRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.MemoryMiBRequest{}
-
-	var min int
-	if v, ok := tfMap["min"].(int); ok {
-		min = v
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["max"].(int); ok && v >= min {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandNetworkBandwidthGbpsRequest(tfMap map[string]interface{}) *ec2.NetworkBandwidthGbpsRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.NetworkBandwidthGbpsRequest{}
-
-	var min float64
-	if v, ok := tfMap["min"].(float64); ok {
-		min = v
-		apiObject.Min = aws.Float64(v)
-	}
-
-	if v, ok := tfMap["max"].(float64); ok && v >= min {
-		apiObject.Max = aws.Float64(v)
-	}
-
-	return apiObject
-}
-
-func expandNetworkInterfaceCountRequest(tfMap map[string]interface{}) *ec2.NetworkInterfaceCountRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.NetworkInterfaceCountRequest{}
-
-	var min int
-	if v, ok := tfMap["min"].(int); ok {
-		min = v
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["max"].(int); ok && v >= min {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
-func expandTotalLocalStorageGBRequest(tfMap map[string]interface{}) *ec2.TotalLocalStorageGBRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.TotalLocalStorageGBRequest{}
-
-	var min float64
-	if v, ok := tfMap["min"].(float64); ok {
-		min = v
-		apiObject.Min = aws.Float64(v)
-	}
-
-	if v, ok := tfMap["max"].(float64); ok && v >= min {
-		apiObject.Max = aws.Float64(v)
-	}
-
-	return apiObject
-}
-
-func expandVCPUCountRangeRequest(tfMap map[string]interface{}) *ec2.VCpuCountRangeRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &ec2.VCpuCountRangeRequest{}
-
-	min := 0
-	if v, ok := tfMap["min"].(int); ok {
-		min = v
-		apiObject.Min = aws.Int64(int64(v))
-	}
-
-	if v, ok := tfMap["max"].(int); ok && v >= min {
-		apiObject.Max = aws.Int64(int64(v))
-	}
-
-	return apiObject
-}
-
 func expandLaunchTemplateSpotMarketOptionsRequest(tfMap map[string]interface{}) *ec2.LaunchTemplateSpotMarketOptionsRequest {
 	if tfMap == nil {
 		return nil
@@ -2580,270 +2298,6 @@ func flattenLaunchTemplateInstanceMarketOptions(apiObject *ec2.LaunchTemplateIns
 	return tfMap
 }
 
-func flattenInstanceRequirements(apiObject *ec2.InstanceRequirements) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.AcceleratorCount; v != nil {
-		tfMap["accelerator_count"] = []interface{}{flattenAcceleratorCount(v)}
-	}
-
-	if v := apiObject.AcceleratorManufacturers; v != nil {
-		tfMap["accelerator_manufacturers"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.AcceleratorNames; v != nil {
-		tfMap["accelerator_names"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.AcceleratorTotalMemoryMiB; v != nil {
-		tfMap["accelerator_total_memory_mib"] = []interface{}{flattenAcceleratorTotalMemoryMiB(v)}
-	}
-
-	if v := apiObject.AcceleratorTypes; v != nil {
-		tfMap["accelerator_types"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.AllowedInstanceTypes; v != nil {
-		tfMap["allowed_instance_types"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.BareMetal; v != nil {
-		tfMap["bare_metal"] = aws.StringValue(v)
-	}
-
-	if v := apiObject.BaselineEbsBandwidthMbps; v != nil {
-		tfMap["baseline_ebs_bandwidth_mbps"] = []interface{}{flattenBaselineEBSBandwidthMbps(v)}
-	}
-
-	if v := apiObject.BurstablePerformance; v != nil {
-		tfMap["burstable_performance"] = aws.StringValue(v)
-	}
-
-	if v := apiObject.CpuManufacturers; v != nil {
-		tfMap["cpu_manufacturers"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.ExcludedInstanceTypes; v != nil {
-		tfMap["excluded_instance_types"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.InstanceGenerations; v != nil {
-		tfMap["instance_generations"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.LocalStorage; v != nil {
-		tfMap["local_storage"] = aws.StringValue(v)
-	}
-
-	if v := apiObject.LocalStorageTypes; v != nil {
-		tfMap["local_storage_types"] = aws.StringValueSlice(v)
-	}
-
-	if v := apiObject.MemoryGiBPerVCpu; v != nil {
-		tfMap["memory_gib_per_vcpu"] = []interface{}{flattenMemoryGiBPerVCPU(v)}
-	}
-
-	if v := apiObject.MemoryMiB; v != nil {
-		tfMap["memory_mib"] = []interface{}{flattenMemoryMiB(v)}
-	}
-
-	if v := apiObject.NetworkBandwidthGbps; v != nil {
-		tfMap["network_bandwidth_gbps"] = []interface{}{flattenNetworkBandwidthGbps(v)}
-	}
-
-	if v := apiObject.NetworkInterfaceCount; v != nil {
-		tfMap["network_interface_count"] = []interface{}{flattenNetworkInterfaceCount(v)}
-	}
-
-	if v := apiObject.OnDemandMaxPricePercentageOverLowestPrice; v != nil {
-		tfMap["on_demand_max_price_percentage_over_lowest_price"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.RequireHibernateSupport; v != nil {
-		tfMap["require_hibernate_support"] = aws.BoolValue(v)
-	}
-
-	if v := apiObject.SpotMaxPricePercentageOverLowestPrice; v != nil {
-		tfMap["spot_max_price_percentage_over_lowest_price"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.TotalLocalStorageGB; v != nil {
-		tfMap["total_local_storage_gb"] = []interface{}{flattenTotalLocalStorageGB(v)}
-	}
-
-	if v := apiObject.VCpuCount; v != nil {
-		tfMap["vcpu_count"] = []interface{}{flattenVCPUCountRange(v)}
-	}
-
-	return tfMap
-}
-
-func flattenAcceleratorCount(apiObject *ec2.AcceleratorCount) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Int64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenAcceleratorTotalMemoryMiB(apiObject *ec2.AcceleratorTotalMemoryMiB) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Int64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenBaselineEBSBandwidthMbps(apiObject *ec2.BaselineEbsBandwidthMbps) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Int64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenMemoryGiBPerVCPU(apiObject *ec2.MemoryGiBPerVCpu) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Float64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Float64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenMemoryMiB(apiObject *ec2.MemoryMiB) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Int64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenNetworkBandwidthGbps(apiObject *ec2.NetworkBandwidthGbps) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Float64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Float64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenNetworkInterfaceCount(apiObject *ec2.NetworkInterfaceCount) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Int64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenTotalLocalStorageGB(apiObject *ec2.TotalLocalStorageGB) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Float64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Float64Value(v)
-	}
-
-	return tfMap
-}
-
-func flattenVCPUCountRange(apiObject *ec2.VCpuCountRange) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.Max; v != nil {
-		tfMap["max"] = aws.Int64Value(v)
-	}
-
-	if v := apiObject.Min; v != nil {
-		tfMap["min"] = aws.Int64Value(v)
-	}
-
-	return tfMap
-}
-
 func flattenLaunchTemplateSpotMarketOptions(apiObject *ec2.LaunchTemplateSpotMarketOptions) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1527,6 +1527,7 @@ func TestAccEC2LaunchTemplate_instanceRequirements_acceleratorCount(t *testing.T
 					resource.TestCheckResourceAttr(resourceName, "instance_requirements.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.accelerator_count.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.accelerator_count.0.max", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.accelerator_count.0.min", "0"),
 				),
 			},
 			{
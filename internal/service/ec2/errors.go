@@ -130,6 +130,32 @@ func CancelSpotFleetRequestsError(apiObjects []*ec2.CancelSpotFleetRequestsError
 	return errors.ErrorOrNil()
 }
 
+func CreateFleetError(apiObject *ec2.CreateFleetError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	err := awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)
+
+	if v := apiObject.LaunchTemplateAndOverrides; v != nil && v.Overrides != nil && v.Overrides.InstanceType != nil {
+		return fmt.Errorf("%s: %w", aws.StringValue(v.Overrides.InstanceType), err)
+	}
+
+	return err
+}
+
+func CreateFleetErrors(apiObjects []*ec2.CreateFleetError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CreateFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func DeleteFleetError(apiObject *ec2.DeleteFleetErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
@@ -2,6 +2,21 @@ package ec2
 
 // Exports for use in tests only.
 var (
-	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
-	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
+	ExpandInstanceRequirements               = expandInstanceRequirements
+	ExpandInstanceRequirementsRequest        = expandInstanceRequirementsRequest
+	FlattenInstanceRequirements              = flattenInstanceRequirements
+	ConfiguredFleetSpotAllocationStrategy    = configuredFleetSpotAllocationStrategy
+	FleetInstanceRequirementsWarnings        = fleetInstanceRequirementsWarnings
+	FleetSpotAllocationStrategyWarnings      = fleetSpotAllocationStrategyWarnings
+	FlattenFleetActiveInstanceSet            = flattenFleetActiveInstanceSet
+	FlattenFleetLaunchTemplateConfigs        = flattenFleetLaunchTemplateConfigs
+	FlattenSpotOptions                       = flattenSpotOptions
+	HashStringCaseInsensitive                = hashStringCaseInsensitive
+	InstantFleetFulfillmentError             = instantFleetFulfillmentError
+	NormalizeFleetAllocationStrategy         = normalizeFleetAllocationStrategy
+	ResourceSecurityGroupEgressRule          = newResourceSecurityGroupEgressRule
+	ResourceSecurityGroupIngressRule         = newResourceSecurityGroupIngressRule
+	SetFleetLaunchTemplateOverridePlacements = setFleetLaunchTemplateOverridePlacements
+	SortFleetLaunchTemplateConfigOverrides   = sortFleetLaunchTemplateConfigOverrides
+	ValidFleetOnDemandOptions                = validFleetOnDemandOptions
 )
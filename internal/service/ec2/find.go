@@ -1237,6 +1237,30 @@ func FindInstanceTypeOfferings(ctx context.Context, conn *ec2.EC2, input *ec2.De
 	return output, nil
 }
 
+func FindInstanceTypesFromInstanceRequirements(ctx context.Context, conn *ec2.EC2, input *ec2.GetInstanceTypesFromInstanceRequirementsInput) ([]*ec2.InstanceTypeInfoFromInstanceRequirements, error) {
+	var output []*ec2.InstanceTypeInfoFromInstanceRequirements
+
+	err := conn.GetInstanceTypesFromInstanceRequirementsPagesWithContext(ctx, input, func(page *ec2.GetInstanceTypesFromInstanceRequirementsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceTypes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindPublicIPv4Pool(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePublicIpv4PoolsInput) (*ec2.PublicIpv4Pool, error) {
 	output, err := FindPublicIPv4Pools(ctx, conn, input)
 
@@ -5319,7 +5343,7 @@ func FindFleetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.FleetDat
 		return nil, err
 	}
 
-	if state := aws.StringValue(output.FleetState); state == ec2.FleetStateCodeDeleted || state == ec2.FleetStateCodeDeletedRunning || state == ec2.FleetStateCodeDeletedTerminating {
+	if state := aws.StringValue(output.FleetState); isFleetStateDeleted(state) {
 		return nil, &retry.NotFoundError{
 			Message:     state,
 			LastRequest: input,
@@ -5336,6 +5360,64 @@ func FindFleetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.FleetDat
 	return output, nil
 }
 
+// FindFleetInstancesByID returns the active instances of the EC2 Fleet with the specified ID.
+// DescribeFleets only returns the instances of instant fleets; those of maintain and request fleets are described separately.
+func FindFleetInstancesByID(ctx context.Context, conn *ec2.EC2, id string) ([]*ec2.ActiveInstance, error) {
+	input := &ec2.DescribeFleetInstancesInput{
+		FleetId: aws.String(id),
+	}
+	var output []*ec2.ActiveInstance
+
+	err := describeFleetInstancesPages(ctx, conn, input, func(page *ec2.DescribeFleetInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ActiveInstances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidFleetIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findFleetByIDIncludingDeleted returns the EC2 Fleet with the specified ID, including fleets in a deleted state.
+func findFleetByIDIncludingDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.FleetData, error) {
+	input := &ec2.DescribeFleetsInput{
+		FleetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindFleet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.FleetId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindFlowLogByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.FlowLog, error) {
 	input := &ec2.DescribeFlowLogsInput{
 		FlowLogIds: aws.StringSlice([]string{id}),
@@ -0,0 +1,180 @@
+package ec2_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func testInstanceRequirementsTFMap() map[string]interface{} {
+	set := func(v ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashString, v)
+	}
+	intRange := func(min, max int) []interface{} {
+		return []interface{}{map[string]interface{}{"min": min, "max": max}}
+	}
+	floatRange := func(min, max float64) []interface{} {
+		return []interface{}{map[string]interface{}{"min": min, "max": max}}
+	}
+
+	return map[string]interface{}{
+		"accelerator_count":                                intRange(1, 2),
+		"accelerator_manufacturers":                        set("nvidia"),
+		"accelerator_names":                                set("t4"),
+		"accelerator_total_memory_mib":                     intRange(1000, 2000),
+		"accelerator_types":                                set("gpu"),
+		"allowed_instance_types":                           set("g4dn.*"),
+		"bare_metal":                                       "excluded",
+		"baseline_ebs_bandwidth_mbps":                      intRange(10, 20),
+		"burstable_performance":                            "excluded",
+		"cpu_manufacturers":                                set("intel"),
+		"excluded_instance_types":                          set(),
+		"instance_generations":                             set("current"),
+		"local_storage":                                    "required",
+		"local_storage_types":                              set("ssd"),
+		"memory_gib_per_vcpu":                              floatRange(0.5, 8),
+		"memory_mib":                                       intRange(500, 0),
+		"network_bandwidth_gbps":                           floatRange(1.5, 10),
+		"network_interface_count":                          intRange(1, 4),
+		"on_demand_max_price_percentage_over_lowest_price": 50,
+		"require_hibernate_support":                        true,
+		"spot_max_price_percentage_over_lowest_price":      75,
+		"total_local_storage_gb":                           floatRange(10, 100),
+		"vcpu_count":                                       intRange(2, 8),
+	}
+}
+
+func TestExpandInstanceRequirementsRequest(t *testing.T) {
+	t.Parallel()
+
+	got := tfec2.ExpandInstanceRequirementsRequest(testInstanceRequirementsTFMap())
+	expected := &ec2.InstanceRequirementsRequest{
+		AcceleratorCount:                          &ec2.AcceleratorCountRequest{Min: aws.Int64(1), Max: aws.Int64(2)},
+		AcceleratorManufacturers:                  aws.StringSlice([]string{"nvidia"}),
+		AcceleratorNames:                          aws.StringSlice([]string{"t4"}),
+		AcceleratorTotalMemoryMiB:                 &ec2.AcceleratorTotalMemoryMiBRequest{Min: aws.Int64(1000), Max: aws.Int64(2000)},
+		AcceleratorTypes:                          aws.StringSlice([]string{"gpu"}),
+		AllowedInstanceTypes:                      aws.StringSlice([]string{"g4dn.*"}),
+		BareMetal:                                 aws.String("excluded"),
+		BaselineEbsBandwidthMbps:                  &ec2.BaselineEbsBandwidthMbpsRequest{Min: aws.Int64(10), Max: aws.Int64(20)},
+		BurstablePerformance:                      aws.String("excluded"),
+		CpuManufacturers:                          aws.StringSlice([]string{"intel"}),
+		InstanceGenerations:                       aws.StringSlice([]string{"current"}),
+		LocalStorage:                              aws.String("required"),
+		LocalStorageTypes:                         aws.StringSlice([]string{"ssd"}),
+		MemoryGiBPerVCpu:                          &ec2.MemoryGiBPerVCpuRequest{Min: aws.Float64(0.5), Max: aws.Float64(8)},
+		MemoryMiB:                                 &ec2.MemoryMiBRequest{Min: aws.Int64(500)},
+		NetworkBandwidthGbps:                      &ec2.NetworkBandwidthGbpsRequest{Min: aws.Float64(1.5), Max: aws.Float64(10)},
+		NetworkInterfaceCount:                     &ec2.NetworkInterfaceCountRequest{Min: aws.Int64(1), Max: aws.Int64(4)},
+		OnDemandMaxPricePercentageOverLowestPrice: aws.Int64(50),
+		RequireHibernateSupport:                   aws.Bool(true),
+		SpotMaxPricePercentageOverLowestPrice:     aws.Int64(75),
+		TotalLocalStorageGB:                       &ec2.TotalLocalStorageGBRequest{Min: aws.Float64(10), Max: aws.Float64(100)},
+		VCpuCount:                                 &ec2.VCpuCountRangeRequest{Min: aws.Int64(2), Max: aws.Int64(8)},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %s\nexpected %s", got, expected)
+	}
+}
+
+func TestExpandInstanceRequirementsRequest_acceleratorCountMaxOnly(t *testing.T) {
+	t.Parallel()
+
+	got := tfec2.ExpandInstanceRequirementsRequest(map[string]interface{}{
+		"accelerator_count": []interface{}{map[string]interface{}{"min": 0, "max": 0}},
+	})
+	expected := &ec2.InstanceRequirementsRequest{
+		AcceleratorCount: &ec2.AcceleratorCountRequest{Max: aws.Int64(0)},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %s\nexpected %s", got, expected)
+	}
+}
+
+func TestExpandInstanceRequirements(t *testing.T) {
+	t.Parallel()
+
+	got := tfec2.ExpandInstanceRequirements(testInstanceRequirementsTFMap())
+	expected := &ec2.InstanceRequirements{
+		AcceleratorCount:                          &ec2.AcceleratorCount{Min: aws.Int64(1), Max: aws.Int64(2)},
+		AcceleratorManufacturers:                  aws.StringSlice([]string{"nvidia"}),
+		AcceleratorNames:                          aws.StringSlice([]string{"t4"}),
+		AcceleratorTotalMemoryMiB:                 &ec2.AcceleratorTotalMemoryMiB{Min: aws.Int64(1000), Max: aws.Int64(2000)},
+		AcceleratorTypes:                          aws.StringSlice([]string{"gpu"}),
+		AllowedInstanceTypes:                      aws.StringSlice([]string{"g4dn.*"}),
+		BareMetal:                                 aws.String("excluded"),
+		BaselineEbsBandwidthMbps:                  &ec2.BaselineEbsBandwidthMbps{Min: aws.Int64(10), Max: aws.Int64(20)},
+		BurstablePerformance:                      aws.String("excluded"),
+		CpuManufacturers:                          aws.StringSlice([]string{"intel"}),
+		InstanceGenerations:                       aws.StringSlice([]string{"current"}),
+		LocalStorage:                              aws.String("required"),
+		LocalStorageTypes:                         aws.StringSlice([]string{"ssd"}),
+		MemoryGiBPerVCpu:                          &ec2.MemoryGiBPerVCpu{Min: aws.Float64(0.5), Max: aws.Float64(8)},
+		MemoryMiB:                                 &ec2.MemoryMiB{Min: aws.Int64(500)},
+		NetworkBandwidthGbps:                      &ec2.NetworkBandwidthGbps{Min: aws.Float64(1.5), Max: aws.Float64(10)},
+		NetworkInterfaceCount:                     &ec2.NetworkInterfaceCount{Min: aws.Int64(1), Max: aws.Int64(4)},
+		OnDemandMaxPricePercentageOverLowestPrice: aws.Int64(50),
+		RequireHibernateSupport:                   aws.Bool(true),
+		SpotMaxPricePercentageOverLowestPrice:     aws.Int64(75),
+		TotalLocalStorageGB:                       &ec2.TotalLocalStorageGB{Min: aws.Float64(10), Max: aws.Float64(100)},
+		VCpuCount:                                 &ec2.VCpuCountRange{Min: aws.Int64(2), Max: aws.Int64(8)},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %s\nexpected %s", got, expected)
+	}
+}
+
+func TestFlattenInstanceRequirements(t *testing.T) {
+	t.Parallel()
+
+	got := tfec2.FlattenInstanceRequirements(tfec2.ExpandInstanceRequirements(testInstanceRequirementsTFMap()))
+	intRange := func(min, max int64) []interface{} {
+		return []interface{}{map[string]interface{}{"min": min, "max": max}}
+	}
+	floatRange := func(min, max float64) []interface{} {
+		return []interface{}{map[string]interface{}{"min": min, "max": max}}
+	}
+	expected := map[string]interface{}{
+		"accelerator_count":                                intRange(1, 2),
+		"accelerator_manufacturers":                        []string{"nvidia"},
+		"accelerator_names":                                []string{"t4"},
+		"accelerator_total_memory_mib":                     intRange(1000, 2000),
+		"accelerator_types":                                []string{"gpu"},
+		"allowed_instance_types":                           []string{"g4dn.*"},
+		"bare_metal":                                       "excluded",
+		"baseline_ebs_bandwidth_mbps":                      intRange(10, 20),
+		"burstable_performance":                            "excluded",
+		"cpu_manufacturers":                                []string{"intel"},
+		"instance_generations":                             []string{"current"},
+		"local_storage":                                    "required",
+		"local_storage_types":                              []string{"ssd"},
+		"memory_gib_per_vcpu":                              floatRange(0.5, 8),
+		"memory_mib":                                       []interface{}{map[string]interface{}{"min": int64(500)}},
+		"network_bandwidth_gbps":                           floatRange(1.5, 10),
+		"network_interface_count":                          intRange(1, 4),
+		"on_demand_max_price_percentage_over_lowest_price": int64(50),
+		"require_hibernate_support":                        true,
+		"spot_max_price_percentage_over_lowest_price":      int64(75),
+		"total_local_storage_gb":                           floatRange(10, 100),
+		"vcpu_count":                                       intRange(2, 8),
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v\nexpected %v", got, expected)
+	}
+}
+
+func TestFlattenInstanceRequirements_nil(t *testing.T) {
+	t.Parallel()
+
+	if got := tfec2.FlattenInstanceRequirements(nil); got != nil {
+		t.Errorf("got %v, expected nil", got)
+	}
+}
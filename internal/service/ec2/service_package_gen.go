@@ -99,6 +99,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCoIPPools,
 			TypeName: "aws_ec2_coip_pools",
 		},
+		{
+			Factory:  DataSourceFleetInstanceTypes,
+			TypeName: "aws_ec2_fleet_instance_types",
+		},
 		{
 			Factory:  DataSourceHost,
 			TypeName: "aws_ec2_host",
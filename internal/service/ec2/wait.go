@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -386,6 +387,37 @@ func WaitFleet(ctx context.Context, conn *ec2.EC2, id string, pending, target []
 	return nil, err
 }
 
+// WaitFleetDeleted waits for a fleet to reach one of the target deleted states or to no longer exist.
+// A fleet that has already been deleted is not waited for, regardless of the delay.
+func WaitFleetDeleted(ctx context.Context, conn *ec2.EC2, id string, pending, target []string, timeout, delay time.Duration) error {
+	refresh := func() (interface{}, string, error) {
+		output, state, err := StatusFleetState(ctx, conn, id)()
+
+		if err != nil || output == nil || slices.Any(target, slices.FilterEquals(state)) {
+			return nil, "", err
+		}
+
+		return output, state, nil
+	}
+
+	if output, _, err := refresh(); err != nil || output == nil {
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      delay,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func WaitImageAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Image, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{ec2.ImageStatePending},
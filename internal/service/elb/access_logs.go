@@ -0,0 +1,149 @@
+package elb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// Regions available after August 2022 deliver access logs using this service principal
+	// instead of a regional Elastic Load Balancing account.
+	accessLogsDeliveryServicePrincipal = "logdelivery.elasticloadbalancing.amazonaws.com"
+)
+
+// accessLogsDeliveryPrincipal returns the type and identifier of the principal that delivers access logs in the specified region.
+func accessLogsDeliveryPrincipal(partition, region string) (string, string) {
+	if accountID, ok := AccountIdPerRegionMap[region]; ok {
+		return "AWS", arn.ARN{
+			Partition: partition,
+			Service:   "iam",
+			AccountID: accountID,
+			Resource:  "root",
+		}.String()
+	}
+
+	return "Service", accessLogsDeliveryServicePrincipal
+}
+
+// bucketPolicyAllowsAccessLogsDelivery returns whether an S3 bucket policy contains a statement
+// allowing the specified principal to put objects. Resources and conditions are not evaluated.
+func bucketPolicyAllowsAccessLogsDelivery(policy, principalType, principal string) (bool, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	type policyStatement struct {
+		Action    interface{}
+		Effect    string
+		Principal interface{}
+	}
+
+	var statements []policyStatement
+
+	// Statement may be a single object or a list of objects.
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement policyStatement
+
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return false, fmt.Errorf("parsing policy: %w", err)
+		}
+
+		statements = append(statements, statement)
+	}
+
+	for _, statement := range statements {
+		if statement.Effect != "Allow" {
+			continue
+		}
+
+		if !policyValuesMatch(statement.Action, func(action string) bool {
+			ok, _ := path.Match(strings.ToLower(action), "s3:putobject")
+			return ok
+		}) {
+			continue
+		}
+
+		switch v := statement.Principal.(type) {
+		case string:
+			if v == "*" {
+				return true, nil
+			}
+		case map[string]interface{}:
+			if policyValuesMatch(v[principalType], func(identifier string) bool {
+				return identifier == "*" || identifier == principal || (principalType == "AWS" && isAccountIDOfARN(identifier, principal))
+			}) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// policyValuesMatch returns whether any of the policy element's values, a single string or a list of strings, satisfies f.
+func policyValuesMatch(v interface{}, f func(string) bool) bool {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok && f(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isAccountIDOfARN(accountID, principal string) bool {
+	v, err := arn.Parse(principal)
+
+	return err == nil && v.AccountID == accountID
+}
+
+// checkAccessLogsBucketPolicy returns a warning if the policy of the specified S3 bucket does not allow access log delivery.
+func checkAccessLogsBucketPolicy(ctx context.Context, meta interface{}, bucket string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	principalType, principal := accessLogsDeliveryPrincipal(client.Partition, client.Region)
+
+	output, err := client.S3Conn().GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		return sdkdiag.AppendWarningf(diags, "access logs S3 bucket (%s) has no bucket policy; access logs will not be delivered unless it allows s3:PutObject to %s principal %q", bucket, principalType, principal)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "unable to verify access logs S3 bucket (%s) policy: %s", bucket, err)
+	}
+
+	ok, err := bucketPolicyAllowsAccessLogsDelivery(aws.StringValue(output.Policy), principalType, principal)
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "unable to verify access logs S3 bucket (%s) policy: %s", bucket, err)
+	}
+
+	if !ok {
+		return sdkdiag.AppendWarningf(diags, "access logs S3 bucket (%s) policy does not allow s3:PutObject to %s principal %q; access logs will not be delivered", bucket, principalType, principal)
+	}
+
+	return diags
+}
@@ -0,0 +1,214 @@
+package elb
+
+import (
+	"testing"
+)
+
+func TestAccessLogsDeliveryPrincipal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		region                string
+		expectedPrincipalType string
+		expectedPrincipal     string
+	}{
+		{
+			region:                "us-east-1",
+			expectedPrincipalType: "AWS",
+			expectedPrincipal:     "arn:aws:iam::127311923021:root",
+		},
+		{
+			region:                "me-central-1",
+			expectedPrincipalType: "Service",
+			expectedPrincipal:     "logdelivery.elasticloadbalancing.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.region, func(t *testing.T) {
+			t.Parallel()
+
+			principalType, principal := accessLogsDeliveryPrincipal("aws", testCase.region)
+
+			if principalType != testCase.expectedPrincipalType || principal != testCase.expectedPrincipal {
+				t.Errorf("got %s %q, expected %s %q", principalType, principal, testCase.expectedPrincipalType, testCase.expectedPrincipal)
+			}
+		})
+	}
+}
+
+func TestBucketPolicyAllowsAccessLogsDelivery(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountPrincipal = "arn:aws:iam::127311923021:root"
+	)
+
+	testCases := []struct {
+		name          string
+		policy        string
+		principalType string
+		principal     string
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "account ARN",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+			expected:      true,
+		},
+		{
+			name: "account ID in list",
+			policy: `{
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"AWS": ["123456789012", "127311923021"]},
+    "Action": ["s3:GetObject", "s3:PutObject"],
+    "Resource": "arn:aws:s3:::example/*"
+  }
+}`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+			expected:      true,
+		},
+		{
+			name: "action wildcard",
+			policy: `{
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+      "Action": "s3:Put*",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+			expected:      true,
+		},
+		{
+			name: "service principal",
+			policy: `{
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "logdelivery.elasticloadbalancing.amazonaws.com"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "Service",
+			principal:     "logdelivery.elasticloadbalancing.amazonaws.com",
+			expected:      true,
+		},
+		{
+			name: "other region account",
+			policy: `{
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::797873946194:root"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+		},
+		{
+			name: "other action",
+			policy: `{
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+		},
+		{
+			name: "deny",
+			policy: `{
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+		},
+		{
+			name: "service principal required",
+			policy: `{
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*"
+    }
+  ]
+}`,
+			principalType: "Service",
+			principal:     "logdelivery.elasticloadbalancing.amazonaws.com",
+		},
+		{
+			name:          "invalid JSON",
+			policy:        `{`,
+			principalType: "AWS",
+			principal:     accountPrincipal,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := bucketPolicyAllowsAccessLogsDelivery(testCase.policy, testCase.principalType, testCase.principal)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
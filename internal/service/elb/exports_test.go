@@ -0,0 +1,6 @@
+package elb
+
+// Exports for use in tests only.
+var (
+	DiffListeners = diffListeners
+)
@@ -77,6 +77,21 @@ func flattenInstances(list []*elb.Instance) []string {
 	return result
 }
 
+// flattenConnectionSettingsIdleTimeout returns the idle timeout of the connection settings.
+// Load balancers that have never had their connection settings modified may not return them,
+// or return an idle timeout of 0, while applying the default idle timeout.
+func flattenConnectionSettingsIdleTimeout(apiObject *elb.ConnectionSettings) int {
+	if apiObject == nil {
+		return loadBalancerIdleTimeoutDefault
+	}
+
+	if v := aws.Int64Value(apiObject.IdleTimeout); v > 0 {
+		return int(v)
+	}
+
+	return loadBalancerIdleTimeoutDefault
+}
+
 // Expands an array of String Instance IDs into a []Instances
 func ExpandInstanceString(list []interface{}) []*elb.Instance {
 	result := make([]*elb.Instance, 0, len(list))
@@ -231,3 +231,35 @@ func TestFlattenPolicyAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenConnectionSettingsIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Input  *elb.ConnectionSettings
+		Output int
+	}{
+		{
+			Input:  nil,
+			Output: 60,
+		},
+		{
+			Input:  &elb.ConnectionSettings{},
+			Output: 60,
+		},
+		{
+			Input:  &elb.ConnectionSettings{IdleTimeout: aws.Int64(0)},
+			Output: 60,
+		},
+		{
+			Input:  &elb.ConnectionSettings{IdleTimeout: aws.Int64(4000)},
+			Output: 4000,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := flattenConnectionSettingsIdleTimeout(tc.Input); got != tc.Output {
+			t.Errorf("Got %d, expected %d for %#v", got, tc.Output, tc.Input)
+		}
+	}
+}
@@ -1,11 +1,11 @@
 package elb
 
 import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
-	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,7 +17,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,6 +32,15 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	errMessageMultipleSubnetsInSameAZ = "cannot be attached to multiple subnets in the same AZ"
+
+	loadBalancerSubnetsAttachedTimeout = 5 * time.Minute
+
+	// The idle timeout, in seconds, of load balancers created without connection settings.
+	loadBalancerIdleTimeoutDefault = 60
+)
+
 // @SDKResource("aws_elb", name="Classic Load Balancer")
 // @Tags(identifierAttribute="id")
 func ResourceLoadBalancer() *schema.Resource {
@@ -44,7 +53,10 @@ func ResourceLoadBalancer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceLoadBalancerCustomizeDiffListeners,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"access_logs": {
@@ -72,6 +84,11 @@ func ResourceLoadBalancer() *schema.Resource {
 							Default:      60,
 							ValidateFunc: ValidAccessLogsInterval,
 						},
+						"verify_bucket_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -152,7 +169,7 @@ func ResourceLoadBalancer() *schema.Resource {
 			"idle_timeout": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				Default:      60,
+				Default:      loadBalancerIdleTimeoutDefault,
 				ValidateFunc: validation.IntBetween(1, 4000),
 			},
 			"instances": {
@@ -178,9 +195,10 @@ func ResourceLoadBalancer() *schema.Resource {
 							ValidateFunc: validation.IntBetween(1, 65535),
 						},
 						"instance_protocol": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validateListenerProtocol(),
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateListenerProtocol(),
+							DiffSuppressFunc: verify.SuppressEquivalentStringCaseInsensitive,
 						},
 						"lb_port": {
 							Type:         schema.TypeInt,
@@ -188,9 +206,10 @@ func ResourceLoadBalancer() *schema.Resource {
 							ValidateFunc: validation.IntBetween(1, 65535),
 						},
 						"lb_protocol": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validateListenerProtocol(),
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateListenerProtocol(),
+							DiffSuppressFunc: verify.SuppressEquivalentStringCaseInsensitive,
 						},
 						"ssl_certificate_id": {
 							Type:         schema.TypeString,
@@ -212,6 +231,7 @@ func ResourceLoadBalancer() *schema.Resource {
 			"name_prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
 				ValidateFunc:  validNamePrefix,
@@ -251,17 +271,12 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBConn()
 
-	var elbName string
-	if v, ok := d.GetOk("name"); ok {
-		elbName = v.(string)
-	} else {
-		if v, ok := d.GetOk("name_prefix"); ok {
-			elbName = id.PrefixedUniqueId(v.(string))
-		} else {
-			elbName = id.PrefixedUniqueId("tf-lb-")
-		}
-		d.Set("name", elbName)
+	namePrefix := "tf-lb-"
+	if v, ok := d.GetOk("name_prefix"); ok {
+		namePrefix = v.(string)
 	}
+	elbName := create.Name(d.Get("name").(string), namePrefix)
+	d.Set("name", elbName)
 
 	// Expand the "listener" set to aws-sdk-go compat []*elb.Listener
 	listeners, err := ExpandListeners(d.Get("listener").(*schema.Set).List())
@@ -350,6 +365,7 @@ func flattenLoadBalancerResource(ctx context.Context, d *schema.ResourceData, ec
 	lbAttrs := describeAttrsResp.LoadBalancerAttributes
 
 	d.Set("name", lb.LoadBalancerName)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(lb.LoadBalancerName)))
 	d.Set("dns_name", lb.DNSName)
 	d.Set("zone_id", lb.CanonicalHostedZoneNameID)
 
@@ -381,9 +397,7 @@ func flattenLoadBalancerResource(ctx context.Context, d *schema.ResourceData, ec
 		}
 	}
 	d.Set("subnets", flex.FlattenStringList(lb.Subnets))
-	if lbAttrs.ConnectionSettings != nil {
-		d.Set("idle_timeout", lbAttrs.ConnectionSettings.IdleTimeout)
-	}
+	d.Set("idle_timeout", flattenConnectionSettingsIdleTimeout(lbAttrs.ConnectionSettings))
 	d.Set("connection_draining", lbAttrs.ConnectionDraining.Enabled)
 	d.Set("connection_draining_timeout", lbAttrs.ConnectionDraining.Timeout)
 	d.Set("cross_zone_load_balancing", lbAttrs.CrossZoneLoadBalancing.Enabled)
@@ -407,7 +421,12 @@ func flattenLoadBalancerResource(ctx context.Context, d *schema.ResourceData, ec
 		if len(nl) == 0 && !*elbal.Enabled {
 			elbal = nil
 		}
-		if err := d.Set("access_logs", flattenAccessLog(elbal)); err != nil {
+		tfList := flattenAccessLog(elbal)
+		// verify_bucket_policy is not returned by the API.
+		if len(tfList) > 0 {
+			tfList[0]["verify_bucket_policy"] = d.Get("access_logs.0.verify_bucket_policy").(bool)
+		}
+		if err := d.Set("access_logs", tfList); err != nil {
 			return fmt.Errorf("reading ELB Classic Load Balancer (%s): setting access_logs: %w", d.Id(), err)
 		}
 	}
@@ -437,12 +456,29 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		remove, _ := ExpandListeners(os.Difference(ns).List())
-		add, err := ExpandListeners(ns.Difference(os).List())
+		remove, add, updateCertificate, err := diffListeners(os.List(), ns.List())
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ELB Classic Load Balancer (%s): %s", d.Id(), err)
 		}
 
+		// Listeners can't be modified in place, so changed listeners are deleted and
+		// recreated. Policies attached to those listeners are re-applied afterwards.
+		var listenerPolicies map[int64][]*string
+
+		if len(remove) > 0 && len(add) > 0 {
+			lb, err := FindLoadBalancerByName(ctx, conn, d.Id())
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer (%s): %s", d.Id(), err)
+			}
+
+			listenerPolicies = make(map[int64][]*string)
+			for _, v := range lb.ListenerDescriptions {
+				if v.Listener != nil && len(v.PolicyNames) > 0 {
+					listenerPolicies[aws.Int64Value(v.Listener.LoadBalancerPort)] = v.PolicyNames
+				}
+			}
+		}
+
 		if len(remove) > 0 {
 			ports := make([]*int64, 0, len(remove))
 			for _, listener := range remove {
@@ -493,6 +529,46 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "Failure adding new or updated ELB listeners: %s", err)
 			}
+
+			for _, listener := range add {
+				lbPort := aws.Int64Value(listener.LoadBalancerPort)
+				policyNames, ok := listenerPolicies[lbPort]
+				if !ok {
+					continue
+				}
+
+				input := &elb.SetLoadBalancerPoliciesOfListenerInput{
+					LoadBalancerName: aws.String(d.Id()),
+					LoadBalancerPort: aws.Int64(lbPort),
+					PolicyNames:      policyNames,
+				}
+
+				if _, err := conn.SetLoadBalancerPoliciesOfListenerWithContext(ctx, input); err != nil {
+					return sdkdiag.AppendErrorf(diags, "re-applying ELB Classic Load Balancer (%s) listener (%d) policies: %s", d.Id(), lbPort, err)
+				}
+			}
+		}
+
+		for _, listener := range updateCertificate {
+			input := &elb.SetLoadBalancerListenerSSLCertificateInput{
+				LoadBalancerName: aws.String(d.Id()),
+				LoadBalancerPort: listener.LoadBalancerPort,
+				SSLCertificateId: listener.SSLCertificateId,
+			}
+
+			err := tfresource.Retry(ctx, 5*time.Minute, func() *retry.RetryError {
+				_, err := conn.SetLoadBalancerListenerSSLCertificateWithContext(ctx, input)
+				if tfawserr.ErrMessageContains(err, elb.ErrCodeCertificateNotFoundException, "Server Certificate not found for the key: arn") {
+					return retry.RetryableError(err)
+				}
+				if err != nil {
+					return retry.NonRetryableError(err)
+				}
+				return nil
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ELB Classic Load Balancer (%s) listener (%d) SSL certificate: %s", d.Id(), aws.Int64Value(listener.LoadBalancerPort), err)
+			}
 		}
 	}
 
@@ -570,6 +646,13 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "Failure configuring ELB attributes: %s", err)
 		}
+
+		// Access logs are delivered asynchronously, so a bucket policy that doesn't allow delivery would otherwise go unnoticed.
+		if len(logs) == 1 {
+			if l := logs[0].(map[string]interface{}); l["enabled"].(bool) && l["verify_bucket_policy"].(bool) {
+				diags = append(diags, checkAccessLogsBucketPolicy(ctx, meta, l["bucket"].(string))...)
+			}
+		}
 	}
 
 	// We have to do these changes separately from everything else since
@@ -690,45 +773,8 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 		removed := flex.ExpandStringSet(os.Difference(ns))
 		added := flex.ExpandStringSet(ns.Difference(os))
 
-		if len(removed) > 0 {
-			detachOpts := &elb.DetachLoadBalancerFromSubnetsInput{
-				LoadBalancerName: aws.String(d.Id()),
-				Subnets:          removed,
-			}
-
-			log.Printf("[DEBUG] ELB detach subnets opts: %s", detachOpts)
-			_, err := conn.DetachLoadBalancerFromSubnetsWithContext(ctx, detachOpts)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "Failure removing ELB subnets: %s", err)
-			}
-		}
-
-		if len(added) > 0 {
-			attachOpts := &elb.AttachLoadBalancerToSubnetsInput{
-				LoadBalancerName: aws.String(d.Id()),
-				Subnets:          added,
-			}
-
-			log.Printf("[DEBUG] ELB attach subnets opts: %s", attachOpts)
-			err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
-				_, err := conn.AttachLoadBalancerToSubnetsWithContext(ctx, attachOpts)
-				if err != nil {
-					if tfawserr.ErrMessageContains(err, elb.ErrCodeInvalidConfigurationRequestException, "cannot be attached to multiple subnets in the same AZ") {
-						// eventually consistent issue with removing a subnet in AZ1 and
-						// immediately adding a new one in the same AZ
-						log.Printf("[DEBUG] retrying az association")
-						return retry.RetryableError(err)
-					}
-					return retry.NonRetryableError(err)
-				}
-				return nil
-			})
-			if tfresource.TimedOut(err) {
-				_, err = conn.AttachLoadBalancerToSubnetsWithContext(ctx, attachOpts)
-			}
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "Failure adding ELB subnets: %s", err)
-			}
+		if err := updateLoadBalancerSubnets(ctx, conn, d.Id(), added, removed); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ELB Classic Load Balancer (%s) subnets: %s", d.Id(), err)
 		}
 	}
 
@@ -757,6 +803,115 @@ func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// updateLoadBalancerSubnets attaches the added subnets before detaching the removed ones so that
+// the load balancer is never left with fewer subnets than it needs while Availability Zones are swapped.
+// A load balancer cannot be attached to more than one subnet per Availability Zone, so if the API
+// rejects the attachment for that reason the removed subnets are detached first instead.
+func updateLoadBalancerSubnets(ctx context.Context, conn *elb.ELB, name string, added, removed []*string) error {
+	if len(added) > 0 {
+		err := attachLoadBalancerToSubnets(ctx, conn, name, added)
+
+		if tfawserr.ErrMessageContains(err, elb.ErrCodeInvalidConfigurationRequestException, errMessageMultipleSubnetsInSameAZ) {
+			log.Printf("[DEBUG] ELB Classic Load Balancer (%s) cannot be attached to new and existing subnets in the same AZ, detaching subnets first", name)
+
+			if err := detachLoadBalancerFromSubnets(ctx, conn, name, removed); err != nil {
+				return err
+			}
+
+			return attachLoadBalancerToSubnetsWithRetry(ctx, conn, name, added)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := waitLoadBalancerSubnetsAttached(ctx, conn, name, added, loadBalancerSubnetsAttachedTimeout); err != nil {
+			return fmt.Errorf("waiting for subnets to attach: %w", err)
+		}
+	}
+
+	return detachLoadBalancerFromSubnets(ctx, conn, name, removed)
+}
+
+func attachLoadBalancerToSubnets(ctx context.Context, conn *elb.ELB, name string, subnetIDs []*string) error {
+	input := &elb.AttachLoadBalancerToSubnetsInput{
+		LoadBalancerName: aws.String(name),
+		Subnets:          subnetIDs,
+	}
+
+	log.Printf("[DEBUG] Attaching ELB Classic Load Balancer (%s) to subnets: %s", name, input)
+	_, err := conn.AttachLoadBalancerToSubnetsWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("attaching subnets: %w", err)
+	}
+
+	return nil
+}
+
+// attachLoadBalancerToSubnetsWithRetry is used after detaching subnets,
+// retrying while the detachment of a subnet in the same AZ is still propagating.
+func attachLoadBalancerToSubnetsWithRetry(ctx context.Context, conn *elb.ELB, name string, subnetIDs []*string) error {
+	input := &elb.AttachLoadBalancerToSubnetsInput{
+		LoadBalancerName: aws.String(name),
+		Subnets:          subnetIDs,
+	}
+
+	log.Printf("[DEBUG] Attaching ELB Classic Load Balancer (%s) to subnets: %s", name, input)
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 5*time.Minute, func() (interface{}, error) {
+		return conn.AttachLoadBalancerToSubnetsWithContext(ctx, input)
+	}, elb.ErrCodeInvalidConfigurationRequestException, errMessageMultipleSubnetsInSameAZ)
+
+	if err != nil {
+		return fmt.Errorf("attaching subnets: %w", err)
+	}
+
+	return nil
+}
+
+func detachLoadBalancerFromSubnets(ctx context.Context, conn *elb.ELB, name string, subnetIDs []*string) error {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	input := &elb.DetachLoadBalancerFromSubnetsInput{
+		LoadBalancerName: aws.String(name),
+		Subnets:          subnetIDs,
+	}
+
+	log.Printf("[DEBUG] Detaching ELB Classic Load Balancer (%s) from subnets: %s", name, input)
+	_, err := conn.DetachLoadBalancerFromSubnetsWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("detaching subnets: %w", err)
+	}
+
+	return nil
+}
+
+func waitLoadBalancerSubnetsAttached(ctx context.Context, conn *elb.ELB, name string, subnetIDs []*string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		lb, err := FindLoadBalancerByName(ctx, conn, name)
+
+		if err != nil {
+			return false, err
+		}
+
+		attached := flex.FlattenStringSet(lb.Subnets)
+
+		for _, subnetID := range subnetIDs {
+			if !attached.Contains(aws.StringValue(subnetID)) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	})
+}
+
 func FindLoadBalancerByName(ctx context.Context, conn *elb.ELB, name string) (*elb.LoadBalancerDescription, error) {
 	input := &elb.DescribeLoadBalancersInput{
 		LoadBalancerNames: aws.StringSlice([]string{name}),
@@ -793,19 +948,110 @@ func FindLoadBalancerByName(ctx context.Context, conn *elb.ELB, name string) (*e
 	return output.LoadBalancerDescriptions[0], nil
 }
 
+// ListenerHash hashes a listener on its load balancer port, which uniquely identifies
+// a listener on a load balancer. Changes to other listener attributes are then
+// planned as in-place updates of that listener rather than a remove and add.
 func ListenerHash(v interface{}) int {
-	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%d-", m["instance_port"].(int)))
-	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["instance_protocol"].(string))))
-	buf.WriteString(fmt.Sprintf("%d-", m["lb_port"].(int)))
-	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["lb_protocol"].(string))))
 
-	if v, ok := m["ssl_certificate_id"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	return create.StringHashcode(fmt.Sprintf("%d-", m["lb_port"].(int)))
+}
+
+// resourceLoadBalancerCustomizeDiffListeners rejects listeners that share a load balancer port.
+// Listeners are identified by lb_port, so all but one of them would otherwise be silently dropped.
+// The configuration is checked as the listener set has already merged such listeners.
+func resourceLoadBalancerCustomizeDiffListeners(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	listeners := diff.GetRawConfig().GetAttr("listener")
+
+	if listeners.IsNull() || !listeners.IsKnown() {
+		return nil
 	}
 
-	return create.StringHashcode(buf.String())
+	counts := make(map[int]int)
+	var duplicates []int
+
+	for it := listeners.ElementIterator(); it.Next(); {
+		_, listener := it.Element()
+
+		if listener.IsNull() || !listener.IsKnown() {
+			continue
+		}
+
+		lbPort := listener.GetAttr("lb_port")
+
+		if lbPort.IsNull() || !lbPort.IsKnown() {
+			continue
+		}
+
+		v, _ := lbPort.AsBigFloat().Int64()
+
+		if counts[int(v)]++; counts[int(v)] == 2 {
+			duplicates = append(duplicates, int(v))
+		}
+	}
+
+	if len(duplicates) > 0 {
+		sort.Ints(duplicates)
+		ports := make([]string, len(duplicates))
+
+		for i, v := range duplicates {
+			ports[i] = strconv.Itoa(v)
+		}
+
+		return fmt.Errorf("listener: each listener must have a unique lb_port, duplicate lb_port: %s", strings.Join(ports, ", "))
+	}
+
+	return nil
+}
+
+// diffListeners returns the listeners to delete and create, and the listeners whose
+// SSL certificate alone changed and so can be updated in place.
+// Listeners are matched on their load balancer port.
+func diffListeners(old, new []interface{}) ([]*elb.Listener, []*elb.Listener, []*elb.Listener, error) {
+	os, err := ExpandListeners(old)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ns, err := ExpandListeners(new)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	oldByPort := make(map[int64]*elb.Listener, len(os))
+	for _, listener := range os {
+		oldByPort[aws.Int64Value(listener.LoadBalancerPort)] = listener
+	}
+
+	var remove, add, updateCertificate []*elb.Listener
+
+	for _, n := range ns {
+		lbPort := aws.Int64Value(n.LoadBalancerPort)
+		o, ok := oldByPort[lbPort]
+		delete(oldByPort, lbPort)
+
+		switch {
+		case !ok:
+			add = append(add, n)
+		case aws.Int64Value(o.InstancePort) != aws.Int64Value(n.InstancePort),
+			!strings.EqualFold(aws.StringValue(o.InstanceProtocol), aws.StringValue(n.InstanceProtocol)),
+			!strings.EqualFold(aws.StringValue(o.Protocol), aws.StringValue(n.Protocol)),
+			aws.StringValue(o.SSLCertificateId) == "" && aws.StringValue(n.SSLCertificateId) != "",
+			aws.StringValue(o.SSLCertificateId) != "" && aws.StringValue(n.SSLCertificateId) == "":
+			remove = append(remove, o)
+			add = append(add, n)
+		case aws.StringValue(o.SSLCertificateId) != aws.StringValue(n.SSLCertificateId):
+			updateCertificate = append(updateCertificate, n)
+		}
+	}
+
+	for _, o := range os {
+		if _, ok := oldByPort[aws.Int64Value(o.LoadBalancerPort)]; ok {
+			remove = append(remove, o)
+		}
+	}
+
+	return remove, add, updateCertificate, nil
 }
 
 func ValidAccessLogsInterval(v interface{}, k string) (ws []string, errors []error) {
@@ -821,60 +1067,69 @@ func ValidAccessLogsInterval(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidHeathCheckTarget validates a Health Check target against the grammar for its protocol:
+// "<PROTOCOL>:<PORT>" for TCP and SSL, and "<PROTOCOL>:<PORT>/<PATH>" for HTTP and HTTPS.
 func ValidHeathCheckTarget(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
-	// Parse the Health Check target value.
-	matches := regexp.MustCompile(`\A(\w+):(\d+)(.+)?\z`).FindStringSubmatch(value)
+	protocol, rest, _ := strings.Cut(value, ":")
 
-	// Check if the value contains a valid target.
-	if matches == nil || len(matches) < 1 {
+	// Check if the value contains a valid protocol.
+	// Invalid protocol? Return immediately, as the
+	// rest of the grammar depends on the protocol.
+	if !isValidProtocol(protocol) {
 		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid Health Check: %s",
-			k, value))
+			"%q contains an invalid Health Check protocol %q in target %q. "+
+				"Valid protocols are either %q, %q, %q, or %q.",
+			k, protocol, value, "TCP", "SSL", "HTTP", "HTTPS"))
 
-		// Invalid target? Return immediately,
-		// there is no need to collect other
-		// errors.
 		return ws, errors
 	}
 
-	// Check if the value contains a valid protocol.
-	if !isValidProtocol(matches[1]) {
+	grammar := healthCheckTargetGrammar(protocol)
+
+	// Parse the port and the optional path.
+	matches := regexp.MustCompile(`\A(\d+)(.*)\z`).FindStringSubmatch(rest)
+
+	if matches == nil {
 		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid Health Check protocol %q. "+
-				"Valid protocols are either %q, %q, %q, or %q.",
-			k, matches[1], "TCP", "SSL", "HTTP", "HTTPS"))
+			"%q contains an invalid Health Check target %q. "+
+				"%s targets must be in the form %s.",
+			k, value, strings.ToUpper(protocol), grammar))
+
+		return ws, errors
 	}
 
 	// Check if the value contains a valid port range.
-	port, _ := strconv.Atoi(matches[2])
-	if port < 1 || port > 65535 {
+	port, err := strconv.Atoi(matches[1])
+	if err != nil || port < 1 || port > 65535 {
 		errors = append(errors, fmt.Errorf(
-			"%q contains an invalid Health Check target port \"%d\". "+
+			"%q contains an invalid Health Check target port %q. "+
 				"Valid port is in the range from 1 to 65535 inclusive.",
-			k, port))
+			k, matches[1]))
 	}
 
-	switch strings.ToLower(matches[1]) {
+	path := matches[2]
+
+	switch strings.ToLower(protocol) {
 	case "tcp", "ssl":
-		// Check if value is in the form <PROTOCOL>:<PORT> for TCP and/or SSL.
-		if matches[3] != "" {
+		if path != "" {
 			errors = append(errors, fmt.Errorf(
-				"%q cannot contain a path in the Health Check target: %s",
-				k, value))
+				"%q cannot contain a path in the Health Check target %q. "+
+					"%s targets must be in the form %s.",
+				k, value, strings.ToUpper(protocol), grammar))
 		}
 
 	case "http", "https":
-		// Check if value is in the form <PROTOCOL>:<PORT>/<PATH> for HTTP and/or HTTPS.
-		if matches[3] == "" {
+		if !strings.HasPrefix(path, "/") {
 			errors = append(errors, fmt.Errorf(
-				"%q must contain a path in the Health Check target: %s",
-				k, value))
+				"%q must contain a path starting with \"/\" in the Health Check target %q. "+
+					"%s targets must be in the form %s.",
+				k, value, strings.ToUpper(protocol), grammar))
 		}
 
 		// Cannot be longer than 1024 multibyte characters.
-		if len([]rune(matches[3])) > 1024 {
+		if len([]rune(path)) > 1024 {
 			errors = append(errors, fmt.Errorf("%q cannot contain a path longer "+
 				"than 1024 characters in the Health Check target: %s",
 				k, value))
@@ -884,6 +1139,18 @@ func ValidHeathCheckTarget(v interface{}, k string) (ws []string, errors []error
 	return ws, errors
 }
 
+// healthCheckTargetGrammar returns the form of a Health Check target for the specified protocol.
+func healthCheckTargetGrammar(protocol string) string {
+	protocol = strings.ToUpper(protocol)
+
+	switch protocol {
+	case "HTTP", "HTTPS":
+		return protocol + ":<PORT>/<PATH> (e.g. " + protocol + ":80/index.html)"
+	default:
+		return protocol + ":<PORT> (e.g. " + protocol + ":80)"
+	}
+}
+
 func isValidProtocol(s string) bool {
 	if s == "" {
 		return false
@@ -10,6 +10,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -146,6 +147,38 @@ func TestAccELBLoadBalancer_AccessLogs_enabled(t *testing.T) {
 	})
 }
 
+func TestAccELBLoadBalancer_AccessLogs_verifyBucketPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+	rName := fmt.Sprintf("tf-test-access-logs-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_accessLogsVerifyBucketPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.verify_bucket_policy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_logs.0.verify_bucket_policy"},
+			},
+		},
+	})
+}
+
 func TestAccELBLoadBalancer_AccessLogs_disabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf elb.LoadBalancerDescription
@@ -205,6 +238,55 @@ func TestAccELBLoadBalancer_namePrefix(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "name", nameRegex),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccELBLoadBalancer_NamePrefix_createBeforeDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_namePrefixCreateBeforeDestroy(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf1),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "name", "test-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "test-"),
+					resource.TestCheckResourceAttr(resourceName, "internal", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoadBalancerConfig_namePrefixCreateBeforeDestroy(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf2),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "name", "test-"),
+					resource.TestCheckResourceAttr(resourceName, "internal", "true"),
+					func(s *terraform.State) error {
+						if aws.StringValue(conf1.LoadBalancerName) == aws.StringValue(conf2.LoadBalancerName) {
+							return fmt.Errorf("ELB Classic Load Balancer (%s) not recreated with a new name", aws.StringValue(conf1.LoadBalancerName))
+						}
+
+						return nil
+					},
+				),
+			},
 		},
 	})
 }
@@ -228,6 +310,11 @@ func TestAccELBLoadBalancer_generatedName(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "name", generatedNameRegexp),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -333,6 +420,143 @@ func TestAccELBLoadBalancer_tags(t *testing.T) {
 	})
 }
 
+func TestAccELBLoadBalancer_tagsOutOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					testAccCheckLoadBalancerAddTag(ctx, &conf, "outofband", "value"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccLoadBalancerConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBLoadBalancer_DefaultTags_providerOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccLoadBalancerConfig_basic,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("providerkey1", "providervalue1", "providerkey2", "providervalue2"),
+					testAccLoadBalancerConfig_basic,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey2", "providervalue2"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "value1"),
+					testAccLoadBalancerConfig_basic,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBLoadBalancer_DefaultTags_overlapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "providervalue1"),
+					testAccLoadBalancerConfig_tags1("overlapkey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("overlapkey1", "providervalue1", "providerkey2", "providervalue2"),
+					testAccLoadBalancerConfig_tags1("overlapkey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey2", "providervalue2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBLoadBalancer_ListenerSSLCertificateID_iamServerCertificate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf elb.LoadBalancerDescription
@@ -405,6 +629,41 @@ func TestAccELBLoadBalancer_Swap_subnets(t *testing.T) {
 	})
 }
 
+func TestAccELBLoadBalancer_Swap_subnetsAcrossAZs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	monitor := &testAccLoadBalancerSubnetsMonitor{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_subnetsAcrossAZs(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() { monitor.start(ctx, t, rName) },
+				Config:    testAccLoadBalancerConfig_subnetsAcrossAZs(rName, 0, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subnets.*", "aws_subnet.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subnets.*", "aws_subnet.test.2", "id"),
+					monitor.checkMinimumSubnets(2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBLoadBalancer_instanceAttaching(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf elb.LoadBalancerDescription
@@ -572,6 +831,83 @@ func TestAccELBLoadBalancer_listener(t *testing.T) {
 	})
 }
 
+func TestAccELBLoadBalancer_Listener_duplicateLBPort(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoadBalancerConfig_listenerDuplicateLBPort,
+				ExpectError: regexp.MustCompile(`each listener must have a unique lb_port, duplicate lb_port: 80`),
+			},
+		},
+	})
+}
+
+func TestAccELBLoadBalancer_Listener_updateInstancePort(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elb.LoadBalancerDescription
+	resourceName := "aws_elb.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_listenerPolicies(rName, 22, 8000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "listener.#", "3"),
+					testAccCheckLoadBalancerListenerPolicy(&conf, 80, rName+"-80"),
+					testAccCheckLoadBalancerListenerPolicy(&conf, 8080, rName+"-8080"),
+				),
+			},
+			{
+				Config: testAccLoadBalancerConfig_listenerPolicies(rName, 2222, 8000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "listener.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "listener.*", map[string]string{
+						"instance_port":     "2222",
+						"instance_protocol": "tcp",
+						"lb_port":           "22",
+						"lb_protocol":       "tcp",
+					}),
+					testAccCheckLoadBalancerListenerPolicy(&conf, 80, rName+"-80"),
+					testAccCheckLoadBalancerListenerPolicy(&conf, 8080, rName+"-8080"),
+				),
+			},
+			{
+				// The recreated listener keeps its policy.
+				Config: testAccLoadBalancerConfig_listenerPolicies(rName, 2222, 8001),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "listener.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "listener.*", map[string]string{
+						"instance_port":     "8001",
+						"instance_protocol": "http",
+						"lb_port":           "80",
+						"lb_protocol":       "http",
+					}),
+					testAccCheckLoadBalancerListenerPolicy(&conf, 80, rName+"-80"),
+					testAccCheckLoadBalancerListenerPolicy(&conf, 8080, rName+"-8080"),
+				),
+			},
+			{
+				Config:   testAccLoadBalancerConfig_listenerPolicies(rName, 2222, 8001),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccELBLoadBalancer_healthCheck(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_elb.test"
@@ -625,6 +961,40 @@ func TestAccELBLoadBalancer_timeout(t *testing.T) {
 	})
 }
 
+func TestAccELBLoadBalancer_idleTimeoutDefaultImport(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_elb.test"
+	rName := fmt.Sprintf("tf-test-idle-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Create the load balancer without modifying its attributes.
+				PreConfig:          testAccCreateLoadBalancer(ctx, t, rName),
+				Config:             testAccLoadBalancerConfig_idleTimeoutDefault(rName),
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateId:      rName,
+				ImportStatePersist: true,
+			},
+			{
+				Config:   testAccLoadBalancerConfig_idleTimeoutDefault(rName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccLoadBalancerConfig_idleTimeoutDefault(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "idle_timeout", "60"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBLoadBalancer_connectionDraining(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_elb.test"
@@ -778,6 +1148,37 @@ func TestLoadBalancerListenerHash(t *testing.T) {
 			},
 			true,
 		},
+		"listeners are identified by lb_port": {
+			map[string]interface{}{
+				"instance_port":     80,
+				"instance_protocol": "http",
+				"lb_port":           80,
+				"lb_protocol":       "http",
+			},
+			map[string]interface{}{
+				"instance_port":      8443,
+				"instance_protocol":  "https",
+				"lb_port":            80,
+				"lb_protocol":        "https",
+				"ssl_certificate_id": "arn:aws:iam::123456789012:server-certificate/test",
+			},
+			true,
+		},
+		"different lb_port": {
+			map[string]interface{}{
+				"instance_port":     80,
+				"instance_protocol": "http",
+				"lb_port":           80,
+				"lb_protocol":       "http",
+			},
+			map[string]interface{}{
+				"instance_port":     80,
+				"instance_protocol": "http",
+				"lb_port":           8080,
+				"lb_protocol":       "http",
+			},
+			false,
+		},
 	}
 
 	for tn, tc := range cases {
@@ -789,6 +1190,94 @@ func TestLoadBalancerListenerHash(t *testing.T) {
 	}
 }
 
+func TestDiffListeners(t *testing.T) {
+	t.Parallel()
+
+	listener := func(lbPort int, lbProtocol string, instancePort int, instanceProtocol, sslCertificateID string) map[string]interface{} {
+		return map[string]interface{}{
+			"instance_port":      instancePort,
+			"instance_protocol":  instanceProtocol,
+			"lb_port":            lbPort,
+			"lb_protocol":        lbProtocol,
+			"ssl_certificate_id": sslCertificateID,
+		}
+	}
+	ports := func(listeners []*elb.Listener) []int64 {
+		var ports []int64
+		for _, v := range listeners {
+			ports = append(ports, aws.Int64Value(v.LoadBalancerPort))
+		}
+		return ports
+	}
+	cert1 := "arn:aws:iam::123456789012:server-certificate/test1"
+	cert2 := "arn:aws:iam::123456789012:server-certificate/test2"
+
+	testCases := []struct {
+		name                      string
+		old, new                  []interface{}
+		expectedRemove            []int64
+		expectedAdd               []int64
+		expectedUpdateCertificate []int64
+	}{
+		{
+			name: "no changes",
+			old:  []interface{}{listener(80, "http", 8000, "http", ""), listener(22, "tcp", 22, "tcp", "")},
+			new:  []interface{}{listener(22, "TCP", 22, "Tcp", ""), listener(80, "http", 8000, "http", "")},
+		},
+		{
+			name:           "instance port changed",
+			old:            []interface{}{listener(80, "http", 8000, "http", ""), listener(22, "tcp", 22, "tcp", ""), listener(8080, "http", 8080, "http", "")},
+			new:            []interface{}{listener(80, "http", 8000, "http", ""), listener(22, "tcp", 2222, "tcp", ""), listener(8080, "http", 8080, "http", "")},
+			expectedRemove: []int64{22},
+			expectedAdd:    []int64{22},
+		},
+		{
+			name:           "added and removed",
+			old:            []interface{}{listener(80, "http", 8000, "http", ""), listener(22, "tcp", 22, "tcp", "")},
+			new:            []interface{}{listener(80, "http", 8000, "http", ""), listener(8080, "http", 8080, "http", "")},
+			expectedRemove: []int64{22},
+			expectedAdd:    []int64{8080},
+		},
+		{
+			name:                      "certificate changed",
+			old:                       []interface{}{listener(443, "https", 8000, "http", cert1)},
+			new:                       []interface{}{listener(443, "https", 8000, "http", cert2)},
+			expectedUpdateCertificate: []int64{443},
+		},
+		{
+			name:           "protocol changed",
+			old:            []interface{}{listener(443, "https", 8000, "http", cert1)},
+			new:            []interface{}{listener(443, "ssl", 8000, "tcp", cert1)},
+			expectedRemove: []int64{443},
+			expectedAdd:    []int64{443},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			remove, add, updateCertificate, err := tfelb.DiffListeners(testCase.old, testCase.new)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := ports(remove), testCase.expectedRemove; !reflect.DeepEqual(got, expected) {
+				t.Errorf("remove: got %v, expected %v", got, expected)
+			}
+			if got, expected := ports(add), testCase.expectedAdd; !reflect.DeepEqual(got, expected) {
+				t.Errorf("add: got %v, expected %v", got, expected)
+			}
+			if got, expected := ports(updateCertificate), testCase.expectedUpdateCertificate; !reflect.DeepEqual(got, expected) {
+				t.Errorf("update certificate: got %v, expected %v", got, expected)
+			}
+		})
+	}
+}
+
 func TestValidLoadBalancerNameCannotBeginWithHyphen(t *testing.T) {
 	t.Parallel()
 
@@ -906,15 +1395,23 @@ func TestValidLoadBalancerHealthCheckTarget(t *testing.T) {
 			ErrCount: 0,
 		},
 		{
-			Value:    "http:80/test",
+			Value:    "http:80/test",
+			ErrCount: 0,
+		},
+		{
+			Value:    fmt.Sprintf("HTTP:8080/%s", randomRunes(5)),
+			ErrCount: 0,
+		},
+		{
+			Value:    "SSL:8080",
 			ErrCount: 0,
 		},
 		{
-			Value:    fmt.Sprintf("HTTP:8080/%s", randomRunes(5)),
+			Value:    "HTTPS:443/",
 			ErrCount: 0,
 		},
 		{
-			Value:    "SSL:8080",
+			Value:    "HTTP:8080/health?check=true",
 			ErrCount: 0,
 		},
 	}
@@ -959,6 +1456,26 @@ func TestValidLoadBalancerHealthCheckTarget(t *testing.T) {
 			Value:    "incorrect:80/",
 			ErrCount: 1,
 		},
+		{
+			Value:    "HTTPS:443",
+			ErrCount: 1,
+		},
+		{
+			Value:    "TCP:8080/health",
+			ErrCount: 1,
+		},
+		{
+			Value:    "HTTP:8080health",
+			ErrCount: 1,
+		},
+		{
+			Value:    "HTTP:/health",
+			ErrCount: 1,
+		},
+		{
+			Value:    "TCP:0",
+			ErrCount: 1,
+		},
 		{
 			Value: fmt.Sprintf("HTTP:8080/%s%s",
 				sdkacctest.RandStringFromCharSet(512, sdkacctest.CharSetAlpha), randomRunes(512)),
@@ -974,6 +1491,104 @@ func TestValidLoadBalancerHealthCheckTarget(t *testing.T) {
 	}
 }
 
+func TestValidLoadBalancerHealthCheckTargetErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value         string
+		expectedError *regexp.Regexp
+	}{
+		{
+			value:         "HTTPS:443",
+			expectedError: regexp.MustCompile(`must contain a path starting with "/" in the Health Check target "HTTPS:443"\. HTTPS targets must be in the form HTTPS:<PORT>/<PATH>`),
+		},
+		{
+			value:         "http:8080health",
+			expectedError: regexp.MustCompile(`must contain a path starting with "/" .* HTTP targets must be in the form HTTP:<PORT>/<PATH>`),
+		},
+		{
+			value:         "TCP:8080/health",
+			expectedError: regexp.MustCompile(`cannot contain a path in the Health Check target "TCP:8080/health"\. TCP targets must be in the form TCP:<PORT> `),
+		},
+		{
+			value:         "SSL:",
+			expectedError: regexp.MustCompile(`invalid Health Check target "SSL:"\. SSL targets must be in the form SSL:<PORT> `),
+		},
+		{
+			value:         "HTTP:health",
+			expectedError: regexp.MustCompile(`invalid Health Check target "HTTP:health"\. HTTP targets must be in the form HTTP:<PORT>/<PATH>`),
+		},
+		{
+			value:         "UDP:53",
+			expectedError: regexp.MustCompile(`invalid Health Check protocol "UDP"`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.value, func(t *testing.T) {
+			t.Parallel()
+
+			_, errors := tfelb.ValidHeathCheckTarget(testCase.value, "target")
+
+			if len(errors) != 1 {
+				t.Fatalf("expected 1 error, got %d: %v", len(errors), errors)
+			}
+
+			if !testCase.expectedError.MatchString(errors[0].Error()) {
+				t.Fatalf("unexpected error: %s", errors[0])
+			}
+		})
+	}
+}
+
+// testAccCreateLoadBalancer creates a load balancer outside of Terraform, in the first available availability zone.
+func testAccCreateLoadBalancer(ctx context.Context, t *testing.T, name string) func() {
+	return func() {
+		ec2conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
+
+		output, err := ec2conn.DescribeAvailabilityZonesWithContext(ctx, &ec2.DescribeAvailabilityZonesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("opt-in-status"),
+					Values: aws.StringSlice([]string{"opt-in-not-required"}),
+				},
+				{
+					Name:   aws.String("state"),
+					Values: aws.StringSlice([]string{ec2.AvailabilityZoneStateAvailable}),
+				},
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("reading EC2 Availability Zones: %s", err)
+		}
+
+		if len(output.AvailabilityZones) == 0 {
+			t.Fatal("no EC2 Availability Zones available")
+		}
+
+		_, err = conn.CreateLoadBalancerWithContext(ctx, &elb.CreateLoadBalancerInput{
+			AvailabilityZones: []*string{output.AvailabilityZones[0].ZoneName},
+			Listeners: []*elb.Listener{
+				{
+					InstancePort:     aws.Int64(8000),
+					InstanceProtocol: aws.String("http"),
+					LoadBalancerPort: aws.Int64(80),
+					Protocol:         aws.String("http"),
+				},
+			},
+			LoadBalancerName: aws.String(name),
+		})
+
+		if err != nil {
+			t.Fatalf("creating ELB Classic Load Balancer (%s): %s", name, err)
+		}
+	}
+}
+
 func testAccCheckLoadBalancerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
@@ -1025,6 +1640,104 @@ func testAccCheckLoadBalancerExists(ctx context.Context, n string, v *elb.LoadBa
 	}
 }
 
+// testAccCheckLoadBalancerAddTag adds a tag to the load balancer outside of Terraform.
+func testAccCheckLoadBalancerAddTag(ctx context.Context, conf *elb.LoadBalancerDescription, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
+
+		_, err := conn.AddTagsWithContext(ctx, &elb.AddTagsInput{
+			LoadBalancerNames: []*string{conf.LoadBalancerName},
+			Tags: []*elb.Tag{{
+				Key:   aws.String(key),
+				Value: aws.String(value),
+			}},
+		})
+
+		return err
+	}
+}
+
+// testAccLoadBalancerSubnetsMonitor polls DescribeLoadBalancers while a configuration is applied
+// and records the smallest number of subnets observed attached to the load balancer.
+type testAccLoadBalancerSubnetsMonitor struct {
+	cancel  context.CancelFunc
+	done    chan struct{}
+	minimum int
+}
+
+func (m *testAccLoadBalancerSubnetsMonitor) start(ctx context.Context, t *testing.T, name string) {
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	m.minimum = -1
+
+	// Stop polling even if the apply fails before checkMinimumSubnets is called.
+	t.Cleanup(func() {
+		m.cancel()
+		<-m.done
+	})
+
+	go func() {
+		defer close(m.done)
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
+
+		for {
+			lb, err := tfelb.FindLoadBalancerByName(ctx, conn, name)
+
+			if err == nil {
+				if n := len(lb.Subnets); m.minimum == -1 || n < m.minimum {
+					m.minimum = n
+				}
+			} else if ctx.Err() == nil {
+				t.Logf("describing ELB Classic Load Balancer (%s): %s", name, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(1 * time.Second):
+			}
+		}
+	}()
+}
+
+func (m *testAccLoadBalancerSubnetsMonitor) checkMinimumSubnets(expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		m.cancel()
+		<-m.done
+
+		if m.minimum == -1 {
+			return fmt.Errorf("ELB Classic Load Balancer was never described during apply")
+		}
+
+		if m.minimum < expected {
+			return fmt.Errorf("ELB Classic Load Balancer had %d subnets attached during apply, expected at least %d", m.minimum, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLoadBalancerListenerPolicy(conf *elb.LoadBalancerDescription, lbPort int64, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, v := range conf.ListenerDescriptions {
+			if aws.Int64Value(v.Listener.LoadBalancerPort) != lbPort {
+				continue
+			}
+
+			for _, name := range aws.StringValueSlice(v.PolicyNames) {
+				if name == policyName {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("ELB Classic Load Balancer listener (%d) policies %s don't include %s", lbPort, aws.StringValueSlice(v.PolicyNames), policyName)
+		}
+
+		return fmt.Errorf("ELB Classic Load Balancer listener (%d) not found", lbPort)
+	}
+}
+
 func testAccCheckLoadBalancerAttributes(conf *elb.LoadBalancerDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		l := elb.Listener{
@@ -1213,6 +1926,39 @@ data "aws_availability_zones" "available" {
 ` + testAccLoadBalancerAccessLogsCommon(r)
 }
 
+func testAccLoadBalancerConfig_accessLogsVerifyBucketPolicy(r string) string {
+	return `
+resource "aws_elb" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  availability_zones = [data.aws_availability_zones.available.names[0], data.aws_availability_zones.available.names[1], data.aws_availability_zones.available.names[2]]
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  access_logs {
+    interval             = 5
+    bucket               = aws_s3_bucket.accesslogs_bucket.bucket
+    verify_bucket_policy = true
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+` + testAccLoadBalancerAccessLogsCommon(r)
+}
+
 func testAccLoadBalancerConfig_accessLogsDisabled(r string) string {
 	return `
 resource "aws_elb" "test" {
@@ -1308,6 +2054,27 @@ resource "aws_elb" "test" {
 }
 `
 
+func testAccLoadBalancerConfig_namePrefixCreateBeforeDestroy(internal bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_elb" "test" {
+  name_prefix        = "test-"
+  availability_zones = [data.aws_availability_zones.available.names[0], data.aws_availability_zones.available.names[1]]
+  internal           = %[1]t
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, internal))
+}
+
 const testAccLoadBalancerConfig_generatedName = `
 data "aws_availability_zones" "available" {
   state = "available"
@@ -1506,6 +2273,57 @@ resource "aws_elb" "test" {
 }
 `
 
+func testAccLoadBalancerConfig_listenerPolicies(rName string, sshInstancePort, httpInstancePort int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_elb" "test" {
+  name               = %[1]q
+  availability_zones = [data.aws_availability_zones.available.names[0], data.aws_availability_zones.available.names[1], data.aws_availability_zones.available.names[2]]
+
+  listener {
+    instance_port     = %[2]d
+    instance_protocol = "tcp"
+    lb_port           = 22
+    lb_protocol       = "tcp"
+  }
+
+  listener {
+    instance_port     = %[3]d
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  listener {
+    instance_port     = 8080
+    instance_protocol = "http"
+    lb_port           = 8080
+    lb_protocol       = "http"
+  }
+}
+
+resource "aws_lb_cookie_stickiness_policy" "test80" {
+  name          = "%[1]s-80"
+  load_balancer = aws_elb.test.id
+  lb_port       = 80
+}
+
+resource "aws_lb_cookie_stickiness_policy" "test8080" {
+  name          = "%[1]s-8080"
+  load_balancer = aws_elb.test.id
+  lb_port       = 8080
+}
+`, rName, sshInstancePort, httpInstancePort)
+}
+
 const testAccLoadBalancerConfig_listenerMultipleListeners = `
 data "aws_availability_zones" "available" {
   state = "available"
@@ -1535,6 +2353,63 @@ resource "aws_elb" "test" {
 }
 `
 
+const testAccLoadBalancerConfig_listenerDuplicateLBPort = `
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_elb" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  listener {
+    instance_port     = 8080
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
+`
+
+func testAccLoadBalancerConfig_idleTimeoutDefault(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_elb" "test" {
+  name               = %[1]q
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  # Load balancers created by the API have cross-zone load balancing disabled.
+  cross_zone_load_balancing = false
+}
+`, rName)
+}
+
 const testAccLoadBalancerConfig_idleTimeout = `
 data "aws_availability_zones" "available" {
   state = "available"
@@ -1843,6 +2718,27 @@ resource "aws_internet_gateway" "gw" {
 }
 `
 
+func testAccLoadBalancerConfig_subnetsAcrossAZs(rName string, subnetIndex1, subnetIndex2 int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 3), fmt.Sprintf(`
+resource "aws_elb" "test" {
+  name     = %[1]q
+  internal = true
+
+  subnets = [
+    aws_subnet.test[%[2]d].id,
+    aws_subnet.test[%[3]d].id,
+  ]
+
+  listener {
+    instance_port     = 80
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
+`, rName, subnetIndex1, subnetIndex2))
+}
+
 const testAccLoadBalancerConfig_subnetSwap = `
 data "aws_availability_zones" "available" {
   state = "available"
@@ -0,0 +1,122 @@
+package elb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_elb_policies")
+func DataSourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"policy_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName := d.Get("load_balancer_name").(string)
+	policies, err := FindLoadBalancerPoliciesByName(ctx, conn, lbName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer (%s) policies: %s", lbName, err)
+	}
+
+	d.SetId(lbName)
+	if err := d.Set("policies", flattenPolicyDescriptions(policies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policies: %s", err)
+	}
+
+	return diags
+}
+
+// FindLoadBalancerPoliciesByName returns all policies created for the specified load balancer.
+func FindLoadBalancerPoliciesByName(ctx context.Context, conn *elb.ELB, lbName string) ([]*elb.PolicyDescription, error) {
+	input := &elb.DescribeLoadBalancerPoliciesInput{
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	output, err := conn.DescribeLoadBalancerPoliciesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, elb.ErrCodeAccessPointNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.PolicyDescriptions, nil
+}
+
+func flattenPolicyDescriptions(apiObjects []*elb.PolicyDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		attributes := make(map[string]interface{}, len(apiObject.PolicyAttributeDescriptions))
+
+		for _, v := range apiObject.PolicyAttributeDescriptions {
+			if v == nil {
+				continue
+			}
+
+			attributes[aws.StringValue(v.AttributeName)] = aws.StringValue(v.AttributeValue)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"policy_attributes": attributes,
+			"policy_name":       aws.StringValue(apiObject.PolicyName),
+			"policy_type_name":  aws.StringValue(apiObject.PolicyTypeName),
+		})
+	}
+
+	return tfList
+}
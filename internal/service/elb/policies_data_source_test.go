@@ -0,0 +1,63 @@
+package elb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBPoliciesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elb_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "load_balancer_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.policy_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.policy_type_name", "LBCookieStickinessPolicyType"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.policy_attributes.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.policy_attributes.CookieExpirationPeriod", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_elb" "test" {
+  name               = %[1]q
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
+
+resource "aws_lb_cookie_stickiness_policy" "test" {
+  name                     = %[1]q
+  load_balancer            = aws_elb.test.id
+  lb_port                  = 80
+  cookie_expiration_period = 600
+}
+
+data "aws_elb_policies" "test" {
+  load_balancer_name = aws_lb_cookie_stickiness_policy.test.load_balancer
+}
+`, rName))
+}
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceHostedZoneID,
 			TypeName: "aws_elb_hosted_zone_id",
 		},
+		{
+			Factory:  DataSourcePolicies,
+			TypeName: "aws_elb_policies",
+		},
 		{
 			Factory:  DataSourceServiceAccount,
 			TypeName: "aws_elb_service_account",
@@ -0,0 +1,273 @@
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_component_version", name="Component Version")
+// @Tags(identifierAttribute="arn")
+func ResourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidStringIsJSONOrYAML,
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	input := &greengrassv2.CreateComponentVersionInput{
+		ClientToken:  aws.String(id.UniqueId()),
+		InlineRecipe: []byte(d.Get("inline_recipe").(string)),
+		Tags:         GetTagsIn(ctx),
+	}
+
+	output, err := conn.CreateComponentVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	output, err := FindComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	if v := output.CreationTimestamp; v != nil {
+		d.Set("creation_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("creation_timestamp", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("publisher", output.Publisher)
+	if output.Status != nil {
+		d.Set("status", output.Status.ComponentState)
+	} else {
+		d.Set("status", nil)
+	}
+
+	// The recipe isn't returned by DescribeComponent and GetComponent returns it reformatted,
+	// so only read it on import. It can't be changed without creating a new version.
+	if _, ok := d.GetOk("inline_recipe"); !ok {
+		recipe, err := findComponentVersionRecipeByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s) recipe: %s", d.Id(), err)
+		}
+
+		d.Set("inline_recipe", recipe)
+	}
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceComponentVersionRead(ctx, d, meta)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	log.Printf("[INFO] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponentWithContext(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindComponentVersionByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) (*greengrassv2.DescribeComponentOutput, error) {
+	input := &greengrassv2.DescribeComponentInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeComponentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findComponentVersionRecipeByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) (string, error) {
+	input := &greengrassv2.GetComponentInput{
+		Arn:                aws.String(arn),
+		RecipeOutputFormat: aws.String(greengrassv2.RecipeOutputFormatJson),
+	}
+
+	output, err := conn.GetComponentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return string(output.Recipe), nil
+}
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.ComponentState), nil
+	}
+}
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if status := output.Status; status != nil && aws.StringValue(status.ComponentState) == greengrassv2.CloudComponentStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
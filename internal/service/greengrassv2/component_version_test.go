@@ -0,0 +1,223 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2ComponentVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(fmt.Sprintf(`components:%s:versions:1.0.0$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestMatchResourceAttr(resourceName, "creation_timestamp", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Example component"),
+					resource.TestCheckResourceAttr(resourceName, "publisher", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DEPLOYABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe"},
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgreengrassv2.ResourceComponentVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe"},
+			},
+			{
+				Config: testAccComponentVersionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_component_version" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindComponentVersionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Component Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentVersionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Component Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		_, err := tfgreengrassv2.FindComponentVersionByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccComponentVersionConfig_recipe(rName string) string {
+	return fmt.Sprintf(`
+locals {
+  recipe = jsonencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = "1.0.0"
+    ComponentDescription = "Example component"
+    ComponentPublisher   = "Terraform"
+    Manifests = [
+      {
+        Platform = {
+          os = "linux"
+        }
+        Lifecycle = {
+          Run = "echo Hello"
+        }
+      }
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccComponentVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccComponentVersionConfig_recipe(rName), `
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = local.recipe
+}
+`)
+}
+
+func testAccComponentVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccComponentVersionConfig_recipe(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = local.recipe
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccComponentVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccComponentVersionConfig_recipe(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = local.recipe
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,150 @@
+package greengrassv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_greengrassv2_components")
+func DataSourceComponents() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComponentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_version_creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      greengrassv2.ComponentVisibilityScopePrivate,
+				ValidateFunc: validation.StringInSlice(greengrassv2.ComponentVisibilityScope_Values(), false),
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	scope := d.Get("scope").(string)
+	tagsToMatch := tftags.New(ctx, d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	// Tags are only returned for components in your account.
+	if scope == greengrassv2.ComponentVisibilityScopePublic && len(tagsToMatch) > 0 {
+		return sdkdiag.AppendErrorf(diags, "tags can only be used to filter %s components", greengrassv2.ComponentVisibilityScopePrivate)
+	}
+
+	input := &greengrassv2.ListComponentsInput{
+		Scope: aws.String(scope),
+	}
+
+	var components []*greengrassv2.Component
+
+	err := conn.ListComponentsPagesWithContext(ctx, input, func(page *greengrassv2.ListComponentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Components {
+			if v != nil {
+				components = append(components, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Greengrass V2 Components: %s", err)
+	}
+
+	var tfList []interface{}
+
+	for _, component := range components {
+		tfMap := flattenComponent(component)
+
+		if scope != greengrassv2.ComponentVisibilityScopePublic && component.LatestVersion != nil {
+			arn := aws.StringValue(component.LatestVersion.Arn)
+			tags, err := ListTags(ctx, conn, arn)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for Greengrass V2 Component Version (%s): %s", arn, err)
+			}
+
+			tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+
+			tfMap["tags"] = tags.Map()
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("components", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting components: %s", err)
+	}
+
+	return diags
+}
+
+func flattenComponent(apiObject *greengrassv2.Component) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"arn":            aws.StringValue(apiObject.Arn),
+		"component_name": aws.StringValue(apiObject.ComponentName),
+	}
+
+	if v := apiObject.LatestVersion; v != nil {
+		tfMap["latest_version"] = aws.StringValue(v.ComponentVersion)
+		tfMap["latest_version_arn"] = aws.StringValue(v.Arn)
+
+		if v := v.CreationTimestamp; v != nil {
+			tfMap["latest_version_creation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+	}
+
+	return tfMap
+}
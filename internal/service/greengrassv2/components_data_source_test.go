@@ -0,0 +1,74 @@
+package greengrassv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGreengrassV2ComponentsDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_greengrassv2_components.test"
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentsDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "components.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "components.0.component_name", resourceName, "component_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "components.0.latest_version", resourceName, "component_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "components.0.latest_version_arn", resourceName, "arn"),
+					resource.TestMatchResourceAttr(dataSourceName, "components.0.latest_version_creation_timestamp", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestCheckResourceAttr(dataSourceName, "components.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "components.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccComponentsDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccComponentVersionConfig_tags1(rName, "Name", rName), `
+data "aws_greengrassv2_components" "test" {
+  tags = aws_greengrassv2_component_version.test.tags
+}
+`)
+}
+
+func TestAccGreengrassV2ComponentsDataSource_tagsPublicScope(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccComponentsDataSourceConfig_tagsPublicScope, greengrassv2.ComponentVisibilityScopePublic),
+				ExpectError: regexp.MustCompile(`tags can only be used to filter PRIVATE components`),
+			},
+		},
+	})
+}
+
+const testAccComponentsDataSourceConfig_tagsPublicScope = `
+data "aws_greengrassv2_components" "test" {
+  scope = %[1]q
+
+  tags = {
+    Name = "example"
+  }
+}
+`
@@ -0,0 +1,195 @@
+package greengrassv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_core_device", name="Core Device")
+// @Tags(identifierAttribute="arn")
+func ResourceCoreDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCoreDeviceCreate,
+		ReadWithoutTimeout:   resourceCoreDeviceRead,
+		UpdateWithoutTimeout: resourceCoreDeviceUpdate,
+		DeleteWithoutTimeout: resourceCoreDeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_device_thing_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"core_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_status_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// resourceCoreDeviceCreate adopts an existing core device. Core devices are created by the
+// AWS IoT Greengrass Core software installer when it registers with the service, not by an API call.
+func resourceCoreDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	thingName := d.Get("core_device_thing_name").(string)
+	output, err := FindCoreDeviceByThingName(ctx, conn, thingName)
+
+	if tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Core Device (%s): core device not found, it must be registered by the AWS IoT Greengrass Core software installer before it can be managed", thingName)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Core Device (%s): %s", thingName, err)
+	}
+
+	d.SetId(thingName)
+
+	// Reconcile the tags of the adopted core device with the configured tags.
+	if err := UpdateTags(ctx, conn, coreDeviceARN(meta.(*conns.AWSClient), d.Id()), output.Tags, GetTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Greengrass V2 Core Device (%s) tags: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCoreDeviceRead(ctx, d, meta)...)
+}
+
+func resourceCoreDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	output, err := FindCoreDeviceByThingName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Core Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Core Device (%s): %s", d.Id(), err)
+	}
+
+	d.Set("architecture", output.Architecture)
+	d.Set("arn", coreDeviceARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("core_device_thing_name", output.CoreDeviceThingName)
+	d.Set("core_version", output.CoreVersion)
+	if output.LastStatusUpdateTimestamp != nil {
+		d.Set("last_status_update_timestamp", aws.TimeValue(output.LastStatusUpdateTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_status_update_timestamp", nil)
+	}
+	d.Set("platform", output.Platform)
+	d.Set("status", output.Status)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceCoreDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceCoreDeviceRead(ctx, d, meta)
+}
+
+func resourceCoreDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	// Deleting a core device doesn't delete the AWS IoT thing or uninstall the AWS IoT Greengrass Core software.
+	log.Printf("[INFO] Deleting Greengrass V2 Core Device: %s", d.Id())
+	_, err := conn.DeleteCoreDeviceWithContext(ctx, &greengrassv2.DeleteCoreDeviceInput{
+		CoreDeviceThingName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Core Device (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// coreDeviceARN returns the ARN of a core device, which GetCoreDevice doesn't return.
+func coreDeviceARN(client *conns.AWSClient, thingName string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "greengrass",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("coreDevices:%s", thingName),
+	}.String()
+}
+
+func FindCoreDeviceByThingName(ctx context.Context, conn *greengrassv2.GreengrassV2, thingName string) (*greengrassv2.GetCoreDeviceOutput, error) {
+	input := &greengrassv2.GetCoreDeviceInput{
+		CoreDeviceThingName: aws.String(thingName),
+	}
+
+	output, err := conn.GetCoreDeviceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,202 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The core device is adopted and deleted once per run, so all steps that need it are in a single test.
+func TestAccGreengrassV2CoreDevice_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	thingName := os.Getenv("GREENGRASSV2_CORE_DEVICE_THING_NAME")
+	resourceName := "aws_greengrassv2_core_device.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCoreDevice(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreDeviceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDeviceConfig_basic(thingName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCoreDeviceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "architecture"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "greengrass", fmt.Sprintf("coreDevices:%s", thingName)),
+					resource.TestCheckResourceAttr(resourceName, "core_device_thing_name", thingName),
+					resource.TestCheckResourceAttrSet(resourceName, "core_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "platform"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_status_update_timestamp",
+					"status",
+				},
+			},
+			{
+				Config: testAccCoreDeviceConfig_tags1(thingName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCoreDeviceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccCoreDeviceConfig_tags2(thingName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCoreDeviceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCoreDeviceConfig_tags1(thingName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCoreDeviceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2CoreDevice_notFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreDeviceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoreDeviceConfig_basic(rName),
+				ExpectError: regexp.MustCompile(`core device not found`),
+			},
+		},
+	})
+}
+
+// testAccPreCheckCoreDevice skips tests that need a core device registered by the AWS IoT Greengrass Core software installer.
+// The core device is deleted when the test's resources are destroyed, so it must be registered again before each run,
+// e.g. by restarting the AWS IoT Greengrass Core software.
+func testAccPreCheckCoreDevice(ctx context.Context, t *testing.T) {
+	acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID)
+
+	thingName := os.Getenv("GREENGRASSV2_CORE_DEVICE_THING_NAME")
+
+	if thingName == "" {
+		t.Skip("GREENGRASSV2_CORE_DEVICE_THING_NAME environment variable not set")
+	}
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+	_, err := tfgreengrassv2.FindCoreDeviceByThingName(ctx, conn, thingName)
+
+	if tfresource.NotFound(err) {
+		t.Skipf("Greengrass V2 Core Device (%s) not registered", thingName)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckCoreDeviceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_core_device" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindCoreDeviceByThingName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Core Device %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCoreDeviceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Core Device ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		_, err := tfgreengrassv2.FindCoreDeviceByThingName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCoreDeviceConfig_basic(thingName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_core_device" "test" {
+  core_device_thing_name = %[1]q
+}
+`, thingName)
+}
+
+func testAccCoreDeviceConfig_tags1(thingName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_core_device" "test" {
+  core_device_thing_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, thingName, tagKey1, tagValue1)
+}
+
+func testAccCoreDeviceConfig_tags2(thingName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_core_device" "test" {
+  core_device_thing_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, thingName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
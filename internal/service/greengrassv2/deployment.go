@@ -0,0 +1,439 @@
+package greengrassv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_deployment", name="Deployment")
+// @Tags(identifierAttribute="arn")
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"component_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"configuration_merge": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"configuration_reset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"deployment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_update_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentComponentUpdatePolicyAction_Values(), false),
+									},
+									"timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"configuration_validation_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"failure_handling_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentFailureHandlingPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	input := &greengrassv2.CreateDeploymentInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Tags:        GetTagsIn(ctx),
+		TargetArn:   aws.String(d.Get("target_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("component"); ok && v.(*schema.Set).Len() > 0 {
+		input.Components = expandComponentDeploymentSpecifications(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("deployment_name"); ok {
+		input.DeploymentName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_policies"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeploymentPolicies = expandDeploymentPolicies(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Deployment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DeploymentId))
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	output, err := FindDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "greengrass",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("deployments:%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("component", flattenComponentDeploymentSpecifications(output.Components)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting component: %s", err)
+	}
+	d.Set("deployment_id", output.DeploymentId)
+	d.Set("deployment_name", output.DeploymentName)
+	if output.DeploymentPolicies != nil {
+		if err := d.Set("deployment_policies", []interface{}{flattenDeploymentPolicies(output.DeploymentPolicies)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_policies: %s", err)
+		}
+	} else {
+		d.Set("deployment_policies", nil)
+	}
+	d.Set("deployment_status", output.DeploymentStatus)
+	d.Set("revision_id", output.RevisionId)
+	d.Set("target_arn", output.TargetArn)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	// Active deployments must be canceled before they can be deleted.
+	if d.Get("deployment_status").(string) == greengrassv2.DeploymentStatusActive {
+		log.Printf("[INFO] Canceling Greengrass V2 Deployment: %s", d.Id())
+		_, err := conn.CancelDeploymentWithContext(ctx, &greengrassv2.CancelDeploymentInput{
+			DeploymentId: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		// The deployment may have completed since it was last read.
+		if err != nil && !tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeConflictException) {
+			return sdkdiag.AppendErrorf(diags, "canceling Greengrass V2 Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Greengrass V2 Deployment: %s", d.Id())
+	_, err := conn.DeleteDeploymentWithContext(ctx, &greengrassv2.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDeploymentByID(ctx context.Context, conn *greengrassv2.GreengrassV2, id string) (*greengrassv2.GetDeploymentOutput, error) {
+	input := &greengrassv2.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeploymentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandComponentDeploymentSpecifications(tfList []interface{}) map[string]*greengrassv2.ComponentDeploymentSpecification {
+	apiObjects := make(map[string]*greengrassv2.ComponentDeploymentSpecification, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentDeploymentSpecification{
+			ComponentVersion: aws.String(tfMap["component_version"].(string)),
+		}
+
+		configurationUpdate := &greengrassv2.ComponentConfigurationUpdate{}
+
+		if v, ok := tfMap["configuration_merge"].(string); ok && v != "" {
+			configurationUpdate.Merge = aws.String(v)
+		}
+
+		if v, ok := tfMap["configuration_reset"].([]interface{}); ok && len(v) > 0 {
+			configurationUpdate.Reset = flex.ExpandStringList(v)
+		}
+
+		if configurationUpdate.Merge != nil || configurationUpdate.Reset != nil {
+			apiObject.ConfigurationUpdate = configurationUpdate
+		}
+
+		apiObjects[tfMap["component_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDeploymentPolicies(tfMap map[string]interface{}) *greengrassv2.DeploymentPolicies {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.DeploymentPolicies{}
+
+	if v, ok := tfMap["component_update_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		componentUpdatePolicy := &greengrassv2.DeploymentComponentUpdatePolicy{}
+
+		if v, ok := tfMap["action"].(string); ok && v != "" {
+			componentUpdatePolicy.Action = aws.String(v)
+		}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			componentUpdatePolicy.TimeoutInSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.ComponentUpdatePolicy = componentUpdatePolicy
+	}
+
+	if v, ok := tfMap["configuration_validation_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		configurationValidationPolicy := &greengrassv2.DeploymentConfigurationValidationPolicy{}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			configurationValidationPolicy.TimeoutInSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.ConfigurationValidationPolicy = configurationValidationPolicy
+	}
+
+	if v, ok := tfMap["failure_handling_policy"].(string); ok && v != "" {
+		apiObject.FailureHandlingPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenComponentDeploymentSpecifications(apiObjects map[string]*greengrassv2.ComponentDeploymentSpecification) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"component_name":    name,
+			"component_version": aws.StringValue(apiObject.ComponentVersion),
+		}
+
+		if v := apiObject.ConfigurationUpdate; v != nil {
+			tfMap["configuration_merge"] = aws.StringValue(v.Merge)
+			tfMap["configuration_reset"] = aws.StringValueSlice(v.Reset)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDeploymentPolicies(apiObject *greengrassv2.DeploymentPolicies) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failure_handling_policy": aws.StringValue(apiObject.FailureHandlingPolicy),
+	}
+
+	if v := apiObject.ComponentUpdatePolicy; v != nil {
+		tfMap["component_update_policy"] = []interface{}{map[string]interface{}{
+			"action":             aws.StringValue(v.Action),
+			"timeout_in_seconds": aws.Int64Value(v.TimeoutInSeconds),
+		}}
+	}
+
+	if v := apiObject.ConfigurationValidationPolicy; v != nil {
+		tfMap["configuration_validation_policy"] = []interface{}{map[string]interface{}{
+			"timeout_in_seconds": aws.Int64Value(v.TimeoutInSeconds),
+		}}
+	}
+
+	return tfMap
+}
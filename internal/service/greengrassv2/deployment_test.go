@@ -0,0 +1,209 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2Deployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`deployments:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":    rName,
+						"component_version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", "aws_iot_thing_group.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgreengrassv2.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_deploymentPolicies(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_deploymentPolicies(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.action", "NOTIFY_COMPONENTS"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.timeout_in_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.configuration_validation_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.configuration_validation_policy.0.timeout_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.failure_handling_policy", "ROLLBACK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_deployment" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		_, err := tfgreengrassv2.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDeploymentConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccComponentVersionConfig_recipe(rName), fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = local.recipe
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_deploymentPolicies(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  deployment_policies {
+    failure_handling_policy = "ROLLBACK"
+
+    component_update_policy {
+      action             = "NOTIFY_COMPONENTS"
+      timeout_in_seconds = 120
+    }
+
+    configuration_validation_policy {
+      timeout_in_seconds = 60
+    }
+  }
+}
+`, rName))
+}
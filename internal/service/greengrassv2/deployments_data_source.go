@@ -0,0 +1,151 @@
+package greengrassv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_greengrassv2_deployments")
+func DataSourceDeployments() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeploymentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_latest_for_target": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"parent_target_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revision_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"history_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentHistoryFilter_Values(), false),
+			},
+			"parent_target_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	input := &greengrassv2.ListDeploymentsInput{}
+
+	if v, ok := d.GetOk("history_filter"); ok {
+		input.HistoryFilter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_target_arn"); ok {
+		input.ParentTargetArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_arn"); ok {
+		input.TargetArn = aws.String(v.(string))
+	}
+
+	var deployments []*greengrassv2.Deployment
+
+	err := conn.ListDeploymentsPagesWithContext(ctx, input, func(page *greengrassv2.ListDeploymentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Deployments {
+			if v != nil {
+				deployments = append(deployments, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Greengrass V2 Deployments: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("deployments", flattenDeployments(deployments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deployments: %s", err)
+	}
+
+	return diags
+}
+
+func flattenDeployments(apiObjects []*greengrassv2.Deployment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"deployment_id":        aws.StringValue(apiObject.DeploymentId),
+			"deployment_name":      aws.StringValue(apiObject.DeploymentName),
+			"deployment_status":    aws.StringValue(apiObject.DeploymentStatus),
+			"is_latest_for_target": aws.BoolValue(apiObject.IsLatestForTarget),
+			"parent_target_arn":    aws.StringValue(apiObject.ParentTargetArn),
+			"revision_id":          aws.StringValue(apiObject.RevisionId),
+			"target_arn":           aws.StringValue(apiObject.TargetArn),
+		}
+
+		if v := apiObject.CreationTimestamp; v != nil {
+			tfMap["creation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
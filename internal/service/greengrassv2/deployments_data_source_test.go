@@ -0,0 +1,65 @@
+package greengrassv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGreengrassV2DeploymentsDataSource_latestOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_greengrassv2_deployments.test"
+	deploymentResourceName := "aws_cloudcontrolapi_resource.test"
+	thingGroupResourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, greengrassv2.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentsDataSourceConfig_latestOnly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.deployment_id", deploymentResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.deployment_name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "deployments.0.deployment_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.is_latest_for_target", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "deployments.0.revision_id"),
+					resource.TestMatchResourceAttr(dataSourceName, "deployments.0.creation_timestamp", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.target_arn", thingGroupResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentsDataSourceConfig_latestOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::GreengrassV2::Deployment"
+
+  desired_state = jsonencode({
+    DeploymentName = %[1]q
+    TargetArn      = aws_iot_thing_group.test.arn
+  })
+}
+
+data "aws_greengrassv2_deployments" "test" {
+  target_arn     = aws_iot_thing_group.test.arn
+  history_filter = "LATEST_ONLY"
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`, rName)
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -25,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -146,23 +149,7 @@ func ResourceBroker() *schema.Resource {
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"console_url": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"endpoints": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"ip_address": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+				Elem:     brokerInstanceResource(),
 			},
 			"ldap_server_metadata": {
 				Type:     schema.TypeList,
@@ -263,12 +250,22 @@ func ResourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"primary_instance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     brokerInstanceResource(),
+			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 				Default:  false,
 			},
+			"secondary_instance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     brokerInstanceResource(),
+			},
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -338,6 +335,8 @@ func ResourceBroker() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			resourceBrokerCustomizeDiffEngineVersion,
+			resourceBrokerCustomizeDiffSubnetIDs,
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if strings.EqualFold(diff.Get("engine_type").(string), mq.EngineTypeRabbitmq) {
 					if v, ok := diff.GetOk("logs.0.audit"); ok {
@@ -353,6 +352,89 @@ func ResourceBroker() *schema.Resource {
 	}
 }
 
+// resourceBrokerCustomizeDiffEngineVersion validates an engine version upgrade at plan time:
+// the API rejects downgrades and versions that are not supported for the broker's host instance type
+// only once the update is applied.
+func resourceBrokerCustomizeDiffEngineVersion(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("engine_version") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("engine_version") || !diff.NewValueKnown("host_instance_type") {
+		return nil
+	}
+
+	o, n := diff.GetChange("engine_version")
+	oldVersion, newVersion := o.(string), n.(string)
+
+	if err := ValidBrokerEngineVersionUpgrade(oldVersion, newVersion); err != nil {
+		return fmt.Errorf("engine_version: %w", err)
+	}
+
+	conn := meta.(*conns.AWSClient).MQConn()
+	engineType := strings.ToUpper(diff.Get("engine_type").(string))
+
+	engineVersions, err := findBrokerEngineVersionsByEngineType(ctx, conn, engineType)
+
+	if err != nil {
+		return fmt.Errorf("reading MQ Broker Engine Types (%s): %w", engineType, err)
+	}
+
+	if !slices.Any(engineVersions, slices.FilterEquals(newVersion)) {
+		return fmt.Errorf("engine_version: %s is not a supported %s engine version (supported versions: %s)", newVersion, engineType, strings.Join(engineVersions, ", "))
+	}
+
+	hostInstanceType := diff.Get("host_instance_type").(string)
+	engineVersions, err = findBrokerInstanceEngineVersions(ctx, conn, engineType, hostInstanceType)
+
+	if err != nil {
+		return fmt.Errorf("reading MQ Broker Instance Options (%s, %s): %w", engineType, hostInstanceType, err)
+	}
+
+	if len(engineVersions) > 0 && !slices.Any(engineVersions, slices.FilterEquals(newVersion)) {
+		return fmt.Errorf("engine_version: %s is not supported for host_instance_type %s (supported versions: %s)", newVersion, hostInstanceType, strings.Join(engineVersions, ", "))
+	}
+
+	return nil
+}
+
+// resourceBrokerCustomizeDiffSubnetIDs validates subnet_ids against the engine type and deployment mode at plan time.
+// As changing subnet_ids, the deployment mode or public accessibility replaces the broker, the API would otherwise
+// only reject the subnets when creating the replacement, after the existing broker has been deleted.
+//
+// publicly_accessible does not take part in the validation. The only subnet requirement that depends on it, at least
+// one subnet for a private CLUSTER_MULTI_AZ broker, is always met as default subnets are used when none are configured.
+// A change to it still triggers the validation, as the replacement is created with the configured subnets.
+// The reason shown in the plan for the replacement can't be customised, as ForceNew carries no message in the SDK.
+func resourceBrokerCustomizeDiffSubnetIDs(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("deployment_mode", "engine_type", "publicly_accessible", "subnet_ids") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("deployment_mode") || !diff.NewValueKnown("engine_type") {
+		return nil
+	}
+
+	// subnet_ids is also computed, so only the configured subnets are validated.
+	subnetIDs := diff.GetRawConfig().GetAttr("subnet_ids")
+
+	if !subnetIDs.IsWhollyKnown() {
+		return nil
+	}
+
+	var subnetCount int
+
+	if !subnetIDs.IsNull() {
+		subnetCount = subnetIDs.LengthInt()
+	}
+
+	if err := ValidBrokerSubnetIDs(diff.Get("engine_type").(string), diff.Get("deployment_mode").(string), subnetCount); err != nil {
+		return fmt.Errorf("subnet_ids: %w", err)
+	}
+
+	return nil
+}
+
 func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).MQConn()
 
@@ -440,7 +522,16 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("engine_type", output.EngineType)
 	d.Set("engine_version", output.EngineVersion)
 	d.Set("host_instance_type", output.HostInstanceType)
-	d.Set("instances", flattenBrokerInstances(output.BrokerInstances))
+	instances := flattenBrokerInstances(output.BrokerInstances)
+	d.Set("instances", instances)
+	// Instances are sorted, so the first is consistently reported as the primary instance.
+	if aws.StringValue(output.DeploymentMode) == mq.DeploymentModeActiveStandbyMultiAz && len(instances) == 2 {
+		d.Set("primary_instance", instances[:1])
+		d.Set("secondary_instance", instances[1:])
+	} else {
+		d.Set("primary_instance", nil)
+		d.Set("secondary_instance", nil)
+	}
 	d.Set("publicly_accessible", output.PubliclyAccessible)
 	d.Set("security_groups", aws.StringValueSlice(output.SecurityGroups))
 	d.Set("storage_type", output.StorageType)
@@ -638,6 +729,71 @@ func FindBrokerByID(ctx context.Context, conn *mq.MQ, id string) (*mq.DescribeBr
 	return output, nil
 }
 
+// findBrokerEngineVersionsByEngineType returns the engine versions available for the specified engine type.
+func findBrokerEngineVersionsByEngineType(ctx context.Context, conn *mq.MQ, engineType string) ([]string, error) {
+	input := &mq.DescribeBrokerEngineTypesInput{
+		EngineType: aws.String(engineType),
+	}
+	var output []string
+
+	err := describeBrokerEngineTypesPages(ctx, conn, input, func(page *mq.DescribeBrokerEngineTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, bet := range page.BrokerEngineTypes {
+			if bet == nil {
+				continue
+			}
+
+			for _, ev := range bet.EngineVersions {
+				if ev == nil {
+					continue
+				}
+
+				if v := aws.StringValue(ev.Name); !slices.Any(output, slices.FilterEquals(v)) {
+					output = append(output, v)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	return output, err
+}
+
+// findBrokerInstanceEngineVersions returns the engine versions supported by the specified engine and host instance types.
+func findBrokerInstanceEngineVersions(ctx context.Context, conn *mq.MQ, engineType, hostInstanceType string) ([]string, error) {
+	input := &mq.DescribeBrokerInstanceOptionsInput{
+		EngineType:       aws.String(engineType),
+		HostInstanceType: aws.String(hostInstanceType),
+	}
+	var output []string
+
+	err := describeBrokerInstanceOptionsPages(ctx, conn, input, func(page *mq.DescribeBrokerInstanceOptionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, bio := range page.BrokerInstanceOptions {
+			if bio == nil {
+				continue
+			}
+
+			for _, v := range aws.StringValueSlice(bio.SupportedEngineVersions) {
+				if !slices.Any(output, slices.FilterEquals(v)) {
+					output = append(output, v)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	return output, err
+}
+
 func statusBrokerState(ctx context.Context, conn *mq.MQ, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindBrokerByID(ctx, conn, id)
@@ -868,6 +1024,53 @@ func flattenEncryptionOptions(encryptionOptions *mq.EncryptionOptions) []interfa
 	return []interface{}{m}
 }
 
+// ValidBrokerEngineVersionUpgrade returns an error if updating a broker from the old to the new engine version
+// would be a downgrade. Versions that cannot be compared are left to the API to validate.
+func ValidBrokerEngineVersionUpgrade(oldVersion, newVersion string) error {
+	o, err := version.NewVersion(oldVersion)
+
+	if err != nil {
+		return nil
+	}
+
+	n, err := version.NewVersion(newVersion)
+
+	if err != nil {
+		return nil
+	}
+
+	if n.LessThan(o) {
+		return fmt.Errorf("downgrading from %s to %s is not supported", oldVersion, newVersion)
+	}
+
+	return nil
+}
+
+// ValidBrokerSubnetIDs returns an error if the number of configured subnets is not supported by a broker
+// with the engine type and deployment mode. Without configured subnets, the broker uses the default subnets.
+// A RabbitMQ CLUSTER_MULTI_AZ broker accepts any number of subnets, whether or not it is publicly accessible.
+// Deployment modes that the engine doesn't support are left to the API to validate.
+func ValidBrokerSubnetIDs(engineType, deploymentMode string, subnetCount int) error {
+	if subnetCount == 0 {
+		return nil
+	}
+
+	engineType = strings.ToUpper(engineType)
+
+	switch deploymentMode = strings.ToUpper(deploymentMode); deploymentMode {
+	case mq.DeploymentModeSingleInstance:
+		if subnetCount != 1 {
+			return fmt.Errorf("a %s broker requires exactly 1 subnet, got %d", deploymentMode, subnetCount)
+		}
+	case mq.DeploymentModeActiveStandbyMultiAz:
+		if engineType == mq.EngineTypeActivemq && subnetCount != 2 {
+			return fmt.Errorf("an %s %s broker requires exactly 2 subnets, got %d", engineType, deploymentMode, subnetCount)
+		}
+	}
+
+	return nil
+}
+
 func ValidBrokerPassword(v interface{}, k string) (ws []string, errors []error) {
 	min := 12
 	max := 250
@@ -1032,10 +1235,39 @@ func flattenConfiguration(config *mq.Configurations) []interface{} {
 	return []interface{}{m}
 }
 
+func brokerInstanceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"console_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// flattenBrokerInstances returns the broker instances sorted by console URL and then IP address,
+// as the API returns the instances of multi-AZ brokers in no particular order.
 func flattenBrokerInstances(instances []*mq.BrokerInstance) []interface{} {
 	if len(instances) == 0 {
 		return []interface{}{}
 	}
+	instances = append([]*mq.BrokerInstance{}, instances...)
+	sort.SliceStable(instances, func(i, j int) bool {
+		if ci, cj := aws.StringValue(instances[i].ConsoleURL), aws.StringValue(instances[j].ConsoleURL); ci != cj {
+			return ci < cj
+		}
+		return aws.StringValue(instances[i].IpAddress) < aws.StringValue(instances[j].IpAddress)
+	})
 	l := make([]interface{}, len(instances))
 	for i, instance := range instances {
 		m := make(map[string]interface{})
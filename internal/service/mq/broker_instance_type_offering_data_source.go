@@ -0,0 +1,132 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKDataSource("aws_mq_broker_instance_type_offering")
+func DataSourceBrokerInstanceTypeOffering() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBrokerInstanceTypeOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(mq.DeploymentMode_Values(), false),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(mq.EngineType_Values(), false),
+			},
+			"host_instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preferred_instance_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(mq.BrokerStorageType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceBrokerInstanceTypeOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MQConn()
+
+	input := &mq.DescribeBrokerInstanceOptionsInput{}
+
+	if v, ok := d.GetOk("engine_type"); ok {
+		input.EngineType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_type"); ok {
+		input.StorageType = aws.String(v.(string))
+	}
+
+	deploymentMode := d.Get("deployment_mode").(string)
+	var foundInstanceTypes []string
+
+	err := describeBrokerInstanceOptionsPages(ctx, conn, input, func(page *mq.DescribeBrokerInstanceOptionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, bio := range page.BrokerInstanceOptions {
+			if bio == nil {
+				continue
+			}
+
+			if deploymentMode != "" && !slices.Any(aws.StringValueSlice(bio.SupportedDeploymentModes), slices.FilterEquals(deploymentMode)) {
+				continue
+			}
+
+			if v := aws.StringValue(bio.HostInstanceType); !slices.Any(foundInstanceTypes, slices.FilterEquals(v)) {
+				foundInstanceTypes = append(foundInstanceTypes, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("reading MQ Broker Instance Options: %s", err)
+	}
+
+	if len(foundInstanceTypes) == 0 {
+		return diag.Errorf("no MQ Broker Instance Type Offerings found matching criteria; try different search")
+	}
+
+	var resultInstanceType string
+
+	// Search preferred instance types in their given order and set result
+	// instance type for first match found
+	if v, ok := d.GetOk("preferred_instance_types"); ok {
+		for _, v := range v.([]interface{}) {
+			if v, ok := v.(string); ok {
+				for _, foundInstanceType := range foundInstanceTypes {
+					if foundInstanceType == v {
+						resultInstanceType = v
+						break
+					}
+				}
+
+				if resultInstanceType != "" {
+					break
+				}
+			}
+		}
+	}
+
+	if resultInstanceType == "" && len(foundInstanceTypes) > 1 {
+		return diag.Errorf("multiple MQ Broker Instance Type Offerings found matching criteria; try different search")
+	}
+
+	if resultInstanceType == "" && len(foundInstanceTypes) == 1 {
+		resultInstanceType = foundInstanceTypes[0]
+	}
+
+	if resultInstanceType == "" {
+		return diag.Errorf("no MQ Broker Instance Type Offerings found matching criteria; try different search")
+	}
+
+	d.SetId(resultInstanceType)
+	d.Set("host_instance_type", resultInstanceType)
+
+	return nil
+}
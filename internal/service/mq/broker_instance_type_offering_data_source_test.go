@@ -0,0 +1,40 @@
+package mq_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMQBrokerInstanceTypeOfferingDataSource_preferredInstanceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mq_broker_instance_type_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, mq.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerInstanceTypeOfferingDataSourceConfig_preferredInstanceTypes(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "host_instance_type", regexp.MustCompile(`^mq\.(t3\.micro|m5\.large)$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccBrokerInstanceTypeOfferingDataSourceConfig_preferredInstanceTypes() string {
+	return `
+data "aws_mq_broker_instance_type_offering" "test" {
+  engine_type     = "ACTIVEMQ"
+  deployment_mode = "SINGLE_INSTANCE"
+
+  preferred_instance_types = ["mq.t3.micro", "mq.m5.large"]
+}
+`
+}
@@ -2,6 +2,7 @@ package mq
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
@@ -10,8 +11,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
+// brokerInstanceTypeSpec describes the compute resources of an MQ broker instance type.
+type brokerInstanceTypeSpec struct {
+	vCPUs     int
+	memoryGiB int
+}
+
+// brokerInstanceTypeSpecs holds the published specifications of the MQ broker instance types.
+// DescribeBrokerInstanceOptions does not return vCPU or memory information.
+// TestAccMQBrokerInstanceTypeOfferingsDataSource_instanceTypeSpecs fails if MQ offers an instance type missing from this table.
+var brokerInstanceTypeSpecs = map[string]brokerInstanceTypeSpec{
+	"mq.t2.micro":     {vCPUs: 1, memoryGiB: 1},
+	"mq.t3.micro":     {vCPUs: 2, memoryGiB: 1},
+	"mq.m4.large":     {vCPUs: 2, memoryGiB: 8},
+	"mq.m5.large":     {vCPUs: 2, memoryGiB: 8},
+	"mq.m5.xlarge":    {vCPUs: 4, memoryGiB: 16},
+	"mq.m5.2xlarge":   {vCPUs: 8, memoryGiB: 32},
+	"mq.m5.4xlarge":   {vCPUs: 16, memoryGiB: 64},
+	"mq.m7g.medium":   {vCPUs: 1, memoryGiB: 4},
+	"mq.m7g.large":    {vCPUs: 2, memoryGiB: 8},
+	"mq.m7g.xlarge":   {vCPUs: 4, memoryGiB: 16},
+	"mq.m7g.2xlarge":  {vCPUs: 8, memoryGiB: 32},
+	"mq.m7g.4xlarge":  {vCPUs: 16, memoryGiB: 64},
+	"mq.m7g.8xlarge":  {vCPUs: 32, memoryGiB: 128},
+	"mq.m7g.12xlarge": {vCPUs: 48, memoryGiB: 192},
+	"mq.m7g.16xlarge": {vCPUs: 64, memoryGiB: 256},
+}
+
 // @SDKDataSource("aws_mq_broker_instance_type_offerings")
 func DataSourceBrokerInstanceTypeOfferings() *schema.Resource {
 	return &schema.Resource{
@@ -60,15 +89,33 @@ func DataSourceBrokerInstanceTypeOfferings() *schema.Resource {
 					},
 				},
 			},
+			"deployment_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(mq.DeploymentMode_Values(), false),
+			},
 			"engine_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice(mq.EngineType_Values(), false),
+				ValidateFunc: validation.StringInSlice(mq.EngineType_Values(), true),
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
 			},
 			"host_instance_type": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"min_memory_gib": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"storage_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -84,7 +131,7 @@ func dataSourceBrokerInstanceTypeOfferingsRead(ctx context.Context, d *schema.Re
 	input := &mq.DescribeBrokerInstanceOptionsInput{}
 
 	if v, ok := d.GetOk("engine_type"); ok {
-		input.EngineType = aws.String(v.(string))
+		input.EngineType = aws.String(strings.ToUpper(v.(string)))
 	}
 
 	if v, ok := d.GetOk("host_instance_type"); ok {
@@ -111,6 +158,9 @@ func dataSourceBrokerInstanceTypeOfferingsRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("reading MQ Broker Instance Options: %s", err)
 	}
 
+	output = filterBrokerInstanceOptionsByDeploymentMode(output, d.Get("deployment_mode").(string))
+	output = filterBrokerInstanceOptionsBySpec(output, d.Get("min_vcpus").(int), d.Get("min_memory_gib").(int))
+
 	d.SetId(meta.(*conns.AWSClient).Region)
 
 	if err := d.Set("broker_instance_options", flattenBrokerInstanceOptions(output)); err != nil {
@@ -120,6 +170,61 @@ func dataSourceBrokerInstanceTypeOfferingsRead(ctx context.Context, d *schema.Re
 	return nil
 }
 
+// filterBrokerInstanceOptionsByDeploymentMode returns the broker instance options that support the specified deployment mode.
+// Deployment modes are engine specific, e.g. CLUSTER_MULTI_AZ is only supported by RabbitMQ and ACTIVE_STANDBY_MULTI_AZ only by ActiveMQ.
+func filterBrokerInstanceOptionsByDeploymentMode(bios []*mq.BrokerInstanceOption, deploymentMode string) []*mq.BrokerInstanceOption {
+	if deploymentMode == "" {
+		return bios
+	}
+
+	var filtered []*mq.BrokerInstanceOption
+
+	for _, bio := range bios {
+		if bio == nil {
+			continue
+		}
+
+		if !slices.Any(aws.StringValueSlice(bio.SupportedDeploymentModes), slices.FilterEquals(deploymentMode)) {
+			continue
+		}
+
+		filtered = append(filtered, bio)
+	}
+
+	return filtered
+}
+
+// filterBrokerInstanceOptionsBySpec returns the broker instance options whose host instance type
+// meets the specified minimum vCPU count and memory size.
+// Instance types with unknown specifications are excluded when any minimum is specified.
+func filterBrokerInstanceOptionsBySpec(bios []*mq.BrokerInstanceOption, minVCPUs, minMemoryGiB int) []*mq.BrokerInstanceOption {
+	if minVCPUs == 0 && minMemoryGiB == 0 {
+		return bios
+	}
+
+	var filtered []*mq.BrokerInstanceOption
+
+	for _, bio := range bios {
+		if bio == nil {
+			continue
+		}
+
+		spec, ok := brokerInstanceTypeSpecs[aws.StringValue(bio.HostInstanceType)]
+
+		if !ok {
+			continue
+		}
+
+		if spec.vCPUs < minVCPUs || spec.memoryGiB < minMemoryGiB {
+			continue
+		}
+
+		filtered = append(filtered, bio)
+	}
+
+	return filtered
+}
+
 func flattenBrokerInstanceOptions(bios []*mq.BrokerInstanceOption) []interface{} {
 	if len(bios) == 0 {
 		return nil
@@ -1,11 +1,16 @@
 package mq_test
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmq "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 )
 
 func TestAccMQBrokerInstanceTypeOfferingsDataSource_basic(t *testing.T) {
@@ -46,6 +51,202 @@ func TestAccMQBrokerInstanceTypeOfferingsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccMQBrokerInstanceTypeOfferingsDataSource_minVCPUs(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mq_broker_instance_type_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, mq.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerInstanceTypeOfferingsDataSourceConfig_minVCPUs(4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "broker_instance_options.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "broker_instance_options.*", map[string]string{
+						"host_instance_type": "mq.m5.xlarge",
+					}),
+					testAccCheckBrokerInstanceTypeOfferingsNotPresent(dataSourceName, "mq.t3.micro"),
+					testAccCheckBrokerInstanceTypeOfferingsNotPresent(dataSourceName, "mq.m5.large"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBrokerInstanceTypeOfferingsDataSource_engineTypeMixedCase(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mq_broker_instance_type_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, mq.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerInstanceTypeOfferingsDataSourceConfig_engineType("ActiveMQ"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine_type", "ACTIVEMQ"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "broker_instance_options.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "broker_instance_options.*", map[string]string{
+						"engine_type": "ACTIVEMQ",
+					}),
+				),
+			},
+			{
+				Config: testAccBrokerInstanceTypeOfferingsDataSourceConfig_engineType("rabbitmq"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine_type", "RABBITMQ"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "broker_instance_options.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "broker_instance_options.*", map[string]string{
+						"engine_type": "RABBITMQ",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBrokerInstanceTypeOfferingsDataSource_rabbitMQ(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mq_broker_instance_type_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, mq.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerInstanceTypeOfferingsDataSourceConfig_engineType(mq.EngineTypeRabbitmq),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "broker_instance_options.*", map[string]string{
+						"engine_type":        mq.EngineTypeRabbitmq,
+						"host_instance_type": "mq.m5.large",
+					}),
+					testAccCheckBrokerInstanceTypeOfferingsDeploymentModes(dataSourceName, mq.DeploymentModeClusterMultiAz, mq.DeploymentModeSingleInstance),
+				),
+			},
+			{
+				Config: testAccBrokerInstanceTypeOfferingsDataSourceConfig_deploymentMode(mq.EngineTypeRabbitmq, mq.DeploymentModeClusterMultiAz),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "broker_instance_options.*", map[string]string{
+						"engine_type":        mq.EngineTypeRabbitmq,
+						"host_instance_type": "mq.m5.large",
+					}),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "broker_instance_options.0.supported_deployment_modes.*", mq.DeploymentModeClusterMultiAz),
+					testAccCheckBrokerInstanceTypeOfferingsNotPresent(dataSourceName, "mq.t3.micro"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckBrokerInstanceTypeOfferingsDeploymentModes checks that the supported deployment modes
+// across all broker instance options are exactly the expected deployment modes.
+func testAccCheckBrokerInstanceTypeOfferingsDeploymentModes(n string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found := make(map[string]bool)
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "broker_instance_options.") && strings.Contains(k, ".supported_deployment_modes.") && !strings.HasSuffix(k, ".#") {
+				found[v] = true
+			}
+		}
+
+		for _, v := range expected {
+			if !found[v] {
+				return fmt.Errorf("%s: expected deployment mode %s not supported by any broker instance option", n, v)
+			}
+
+			delete(found, v)
+		}
+
+		if len(found) > 0 {
+			return fmt.Errorf("%s: unexpected deployment modes %v", n, found)
+		}
+
+		return nil
+	}
+}
+
+func TestAccMQBrokerInstanceTypeOfferingsDataSource_instanceTypeSpecs(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mq_broker_instance_type_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, mq.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerInstanceTypeOfferingsDataSourceConfig_all(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "broker_instance_options.#"),
+					testAccCheckBrokerInstanceTypeOfferingsSpecsKnown(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckBrokerInstanceTypeOfferingsSpecsKnown verifies that every offered host instance type
+// has an entry in the table used by the min_vcpus and min_memory_gib filters.
+func testAccCheckBrokerInstanceTypeOfferingsSpecsKnown(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		missing := make(map[string]struct{})
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "broker_instance_options.") || !strings.HasSuffix(k, ".host_instance_type") {
+				continue
+			}
+
+			if _, ok := tfmq.BrokerInstanceTypeSpecs[v]; !ok {
+				missing[v] = struct{}{}
+			}
+		}
+
+		if len(missing) > 0 {
+			instanceTypes := make([]string, 0, len(missing))
+			for v := range missing {
+				instanceTypes = append(instanceTypes, v)
+			}
+			sort.Strings(instanceTypes)
+
+			return fmt.Errorf("%s: host instance types missing from the MQ broker instance type specifications: %s", n, strings.Join(instanceTypes, ", "))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBrokerInstanceTypeOfferingsNotPresent(n, instanceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "broker_instance_options.") && strings.HasSuffix(k, ".host_instance_type") && v == instanceType {
+				return fmt.Errorf("%s: unexpected host instance type %s in %s", n, instanceType, k)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccBrokerInstanceTypeOfferingsDataSourceConfig_basic() string {
 	return `
 data "aws_mq_broker_instance_type_offerings" "empty" {}
@@ -69,3 +270,35 @@ data "aws_mq_broker_instance_type_offerings" "all" {
 }
 `
 }
+
+func testAccBrokerInstanceTypeOfferingsDataSourceConfig_minVCPUs(minVCPUs int) string {
+	return fmt.Sprintf(`
+data "aws_mq_broker_instance_type_offerings" "test" {
+  engine_type = "ACTIVEMQ"
+  min_vcpus   = %[1]d
+}
+`, minVCPUs)
+}
+
+func testAccBrokerInstanceTypeOfferingsDataSourceConfig_engineType(engineType string) string {
+	return fmt.Sprintf(`
+data "aws_mq_broker_instance_type_offerings" "test" {
+  engine_type = %[1]q
+}
+`, engineType)
+}
+
+func testAccBrokerInstanceTypeOfferingsDataSourceConfig_deploymentMode(engineType, deploymentMode string) string {
+	return fmt.Sprintf(`
+data "aws_mq_broker_instance_type_offerings" "test" {
+  engine_type     = %[1]q
+  deployment_mode = %[2]q
+}
+`, engineType, deploymentMode)
+}
+
+func testAccBrokerInstanceTypeOfferingsDataSourceConfig_all() string {
+	return `
+data "aws_mq_broker_instance_type_offerings" "test" {}
+`
+}
@@ -3,7 +3,10 @@ package mq_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -102,6 +105,130 @@ func TestBrokerPasswordValidation(t *testing.T) {
 	}
 }
 
+func TestValidBrokerEngineVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		OldVersion  string
+		NewVersion  string
+		ExpectError bool
+	}{
+		{
+			OldVersion: "5.15.12",
+			NewVersion: "5.16.3",
+		},
+		{
+			OldVersion: "5.16.3",
+			NewVersion: "5.16.3",
+		},
+		{
+			OldVersion: "5.16.3",
+			NewVersion: "5.17.1",
+		},
+		{
+			OldVersion:  "5.16.3",
+			NewVersion:  "5.15.12",
+			ExpectError: true,
+		},
+		{
+			OldVersion:  "3.10.20",
+			NewVersion:  "3.9.27",
+			ExpectError: true,
+		},
+		{
+			OldVersion: "5.16.3",
+			NewVersion: "latest",
+		},
+	}
+
+	for _, tc := range cases {
+		err := tfmq.ValidBrokerEngineVersionUpgrade(tc.OldVersion, tc.NewVersion)
+
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected error upgrading from %s to %s", tc.OldVersion, tc.NewVersion)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Unexpected error upgrading from %s to %s: %s", tc.OldVersion, tc.NewVersion, err)
+		}
+	}
+}
+
+func TestValidBrokerSubnetIDs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		EngineType     string
+		DeploymentMode string
+		SubnetCount    int
+		ExpectError    bool
+	}{
+		{
+			EngineType:     "ActiveMQ",
+			DeploymentMode: "SINGLE_INSTANCE",
+		},
+		{
+			EngineType:     "ActiveMQ",
+			DeploymentMode: "SINGLE_INSTANCE",
+			SubnetCount:    1,
+		},
+		{
+			EngineType:     "RabbitMQ",
+			DeploymentMode: "single_instance",
+			SubnetCount:    1,
+		},
+		{
+			EngineType:     "RabbitMQ",
+			DeploymentMode: "SINGLE_INSTANCE",
+			SubnetCount:    2,
+			ExpectError:    true,
+		},
+		{
+			EngineType:     "ActiveMQ",
+			DeploymentMode: "ACTIVE_STANDBY_MULTI_AZ",
+			SubnetCount:    2,
+		},
+		{
+			EngineType:     "ActiveMQ",
+			DeploymentMode: "ACTIVE_STANDBY_MULTI_AZ",
+			SubnetCount:    1,
+			ExpectError:    true,
+		},
+		{
+			EngineType:     "ACTIVEMQ",
+			DeploymentMode: "ACTIVE_STANDBY_MULTI_AZ",
+			SubnetCount:    3,
+			ExpectError:    true,
+		},
+		{
+			EngineType:     "RabbitMQ",
+			DeploymentMode: "CLUSTER_MULTI_AZ",
+		},
+		{
+			EngineType:     "RabbitMQ",
+			DeploymentMode: "CLUSTER_MULTI_AZ",
+			SubnetCount:    1,
+		},
+		{
+			EngineType:     "RabbitMQ",
+			DeploymentMode: "CLUSTER_MULTI_AZ",
+			SubnetCount:    4,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tfmq.ValidBrokerSubnetIDs(tc.EngineType, tc.DeploymentMode, tc.SubnetCount)
+
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected error for %s %s broker with %d subnets", tc.EngineType, tc.DeploymentMode, tc.SubnetCount)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Unexpected error for %s %s broker with %d subnets: %s", tc.EngineType, tc.DeploymentMode, tc.SubnetCount, err)
+		}
+	}
+}
+
 func TestDiffUsers(t *testing.T) {
 	t.Parallel()
 
@@ -232,6 +359,37 @@ const (
 	testAccRabbitVersion      = "3.8.6"   // before changing, check b/c must be valid on GovCloud
 )
 
+func TestFlattenBrokerInstances(t *testing.T) {
+	t.Parallel()
+
+	instances := []*mq.BrokerInstance{
+		{
+			ConsoleURL: aws.String("https://b-2.mq.us-west-2.amazonaws.com:8162"),
+			IpAddress:  aws.String("10.0.1.10"),
+		},
+		{
+			ConsoleURL: aws.String("https://b-1.mq.us-west-2.amazonaws.com:8162"),
+			IpAddress:  aws.String("10.0.0.10"),
+		},
+	}
+
+	got := tfmq.FlattenBrokerInstances(instances)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 instances, got %d", len(got))
+	}
+
+	for i, expected := range []string{"https://b-1.mq.us-west-2.amazonaws.com:8162", "https://b-2.mq.us-west-2.amazonaws.com:8162"} {
+		if v := got[i].(map[string]interface{})["console_url"]; v != expected {
+			t.Errorf("instance %d: got console_url %q, expected %q", i, v, expected)
+		}
+	}
+
+	if v := aws.StringValue(instances[0].ConsoleURL); v != "https://b-2.mq.us-west-2.amazonaws.com:8162" {
+		t.Errorf("input instances were reordered: %q", v)
+	}
+}
+
 func TestAccMQBroker_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -731,6 +889,54 @@ func TestAccMQBroker_AllFields_customVPC(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_activeStandbyInstances(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerResponse
+	var consoleURLs []string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, mq.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerConfig_activeStandby(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "ACTIVE_STANDBY_MULTI_AZ"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "primary_instance.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_instance.0.console_url", resourceName, "instances.0.console_url"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_instance.0.ip_address", resourceName, "instances.0.ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_instance.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "secondary_instance.0.console_url", resourceName, "instances.1.console_url"),
+					resource.TestCheckResourceAttrPair(resourceName, "secondary_instance.0.ip_address", resourceName, "instances.1.ip_address"),
+					testAccCheckBrokerInstancesOrder(resourceName, &consoleURLs),
+				),
+			},
+			{
+				RefreshState: true,
+				Check:        testAccCheckBrokerInstancesOrder(resourceName, &consoleURLs),
+			},
+			{
+				RefreshState: true,
+				Check:        testAccCheckBrokerInstancesOrder(resourceName, &consoleURLs),
+			},
+		},
+	})
+}
+
 func TestAccMQBroker_EncryptionOptions_kmsKeyID(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1023,6 +1229,10 @@ func TestAccMQBroker_Update_engineVersion(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "engine_version", testAccBrokerVersionNewer),
 				),
 			},
+			{
+				Config:      testAccBrokerConfig_engineVersionUpdate(rName, testAccBrokerVersionOlder),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`downgrading from %s to %s is not supported`, regexp.QuoteMeta(testAccBrokerVersionNewer), regexp.QuoteMeta(testAccBrokerVersionOlder))),
+			},
 		},
 	})
 }
@@ -1264,6 +1474,28 @@ func TestAccMQBroker_RabbitMQ_cluster(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_RabbitMQ_invalidSubnetIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, mq.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBrokerConfig_rabbitSubnetIDs(rName, testAccRabbitVersion, "SINGLE_INSTANCE"),
+				ExpectError: regexp.MustCompile(`subnet_ids: a SINGLE_INSTANCE broker requires exactly 1 subnet, got 2`),
+			},
+		},
+	})
+}
+
 func TestAccMQBroker_ldap(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1335,6 +1567,39 @@ func testAccCheckBrokerDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckBrokerInstancesOrder checks that the broker's instances are sorted by console URL
+// and, if consoleURLs was populated by a previous call, that their order hasn't changed.
+func testAccCheckBrokerInstancesOrder(n string, consoleURLs *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["instances.#"])
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for i := 0; i < count; i++ {
+			got = append(got, rs.Primary.Attributes[fmt.Sprintf("instances.%d.console_url", i)])
+		}
+
+		if !sort.StringsAreSorted(got) {
+			return fmt.Errorf("MQ Broker (%s) instances are not sorted by console URL: %v", rs.Primary.ID, got)
+		}
+
+		if *consoleURLs != nil && !reflect.DeepEqual(*consoleURLs, got) {
+			return fmt.Errorf("MQ Broker (%s) instances changed order: %v, was %v", rs.Primary.ID, got, *consoleURLs)
+		}
+
+		*consoleURLs = got
+
+		return nil
+	}
+}
+
 func testAccCheckBrokerExists(ctx context.Context, n string, v *mq.DescribeBrokerResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1386,6 +1651,33 @@ func testAccCheckBrokerNotRecreated(before, after *mq.DescribeBrokerResponse) re
 	}
 }
 
+func testAccBrokerConfig_activeStandby(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  deployment_mode    = "ACTIVE_STANDBY_MULTI_AZ"
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+  storage_type       = "efs"
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version)
+}
+
 func testAccBrokerConfig_basic(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -2043,6 +2335,24 @@ resource "aws_mq_broker" "test" {
 `, rName, version)
 }
 
+func testAccBrokerConfig_rabbitSubnetIDs(rName, version, deploymentMode string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  engine_type        = "RabbitMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.m5.large"
+  deployment_mode    = %[3]q
+  subnet_ids         = ["subnet-11111111", "subnet-22222222"]
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version, deploymentMode)
+}
+
 func testAccBrokerConfig_ldap(rName, version, ldapUsername string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -3,8 +3,11 @@ package mq
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
@@ -21,6 +24,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	configurationRevisionPropagationTimeout = 2 * time.Minute
+)
+
 // @SDKResource("aws_mq_configuration", name="Configuration")
 // @Tags(identifierAttribute="arn")
 func ResourceConfiguration() *schema.Resource {
@@ -35,6 +42,27 @@ func ResourceConfiguration() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if !diff.NewValueKnown("engine_type") {
+					return nil
+				}
+
+				engineType := diff.Get("engine_type").(string)
+
+				if strings.EqualFold(engineType, mq.EngineTypeRabbitmq) && diff.NewValueKnown("authentication_strategy") {
+					if v := diff.Get("authentication_strategy").(string); strings.EqualFold(v, mq.AuthenticationStrategyLdap) {
+						return fmt.Errorf("authentication_strategy %q is not supported for engine_type %q", v, engineType)
+					}
+				}
+
+				if diff.NewValueKnown("data") {
+					if err := ValidConfigurationData(engineType, diff.Get("data").(string)); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if diff.HasChange("description") {
 					return diff.SetNewComputed("latest_revision")
@@ -43,7 +71,7 @@ func ResourceConfiguration() *schema.Resource {
 					o, n := diff.GetChange("data")
 					os := o.(string)
 					ns := n.(string)
-					if !suppressXMLEquivalentConfig("data", os, ns, nil) {
+					if !configurationDataEquivalent(diff.Get("engine_type").(string), os, ns) {
 						return diff.SetNewComputed("latest_revision")
 					}
 				}
@@ -66,7 +94,7 @@ func ResourceConfiguration() *schema.Resource {
 			"data": {
 				Type:                  schema.TypeString,
 				Required:              true,
-				DiffSuppressFunc:      suppressXMLEquivalentConfig,
+				DiffSuppressFunc:      suppressEquivalentConfigurationData,
 				DiffSuppressOnRefresh: true,
 			},
 			"description": {
@@ -132,11 +160,15 @@ func resourceConfigurationCreate(ctx context.Context, d *schema.ResourceData, me
 			input.Description = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateConfigurationWithContext(ctx, input)
+		output, err := conn.UpdateConfigurationWithContext(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating MQ Configuration (%s): %s", d.Id(), err)
 		}
+
+		if err := waitConfigurationRevision(ctx, conn, d.Id(), aws.Int64Value(output.LatestRevision.Revision)); err != nil {
+			return diag.Errorf("waiting for MQ Configuration (%s) revision: %s", d.Id(), err)
+		}
 	}
 
 	return resourceConfigurationRead(ctx, d, meta)
@@ -201,11 +233,15 @@ func resourceConfigurationUpdate(ctx context.Context, d *schema.ResourceData, me
 			input.Description = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateConfigurationWithContext(ctx, input)
+		output, err := conn.UpdateConfigurationWithContext(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating MQ Configuration (%s): %s", d.Id(), err)
 		}
+
+		if err := waitConfigurationRevision(ctx, conn, d.Id(), aws.Int64Value(output.LatestRevision.Revision)); err != nil {
+			return diag.Errorf("waiting for MQ Configuration (%s) revision: %s", d.Id(), err)
+		}
 	}
 
 	return resourceConfigurationRead(ctx, d, meta)
@@ -236,6 +272,32 @@ func FindConfigurationByID(ctx context.Context, conn *mq.MQ, id string) (*mq.Des
 	return output, nil
 }
 
+// waitConfigurationRevision waits for the configuration's latest revision to be at least the specified revision,
+// so that the revision created by an update is the one read back.
+func waitConfigurationRevision(ctx context.Context, conn *mq.MQ, id string, revision int64) error {
+	return tfresource.WaitUntil(ctx, configurationRevisionPropagationTimeout, func() (bool, error) {
+		output, err := FindConfigurationByID(ctx, conn, id)
+
+		if err != nil {
+			return false, err
+		}
+
+		return aws.Int64Value(output.LatestRevision.Revision) >= revision, nil
+	}, tfresource.WaitOpts{})
+}
+
+func suppressEquivalentConfigurationData(k, old, new string, d *schema.ResourceData) bool {
+	return configurationDataEquivalent(d.Get("engine_type").(string), old, new)
+}
+
+func configurationDataEquivalent(engineType, old, new string) bool {
+	if strings.EqualFold(engineType, mq.EngineTypeRabbitmq) {
+		return CanonicalRabbitMQConfig(old) == CanonicalRabbitMQConfig(new)
+	}
+
+	return suppressXMLEquivalentConfig("data", old, new, nil)
+}
+
 func suppressXMLEquivalentConfig(k, old, new string, d *schema.ResourceData) bool {
 	os, err := CanonicalXML(old)
 	if err != nil {
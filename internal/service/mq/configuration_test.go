@@ -96,6 +96,67 @@ func TestAccMQConfiguration_withData(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "ActiveMQ"),
+					resource.TestCheckResourceAttr(resourceName, "latest_revision", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQConfiguration_rabbitMQ(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, mq.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, mq.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationConfig_rabbitMQ(rName, "consumer_timeout"),
+				ExpectError: regexp.MustCompile(`is not of the form "key = value"`),
+			},
+			{
+				Config: testAccConfigurationConfig_rabbitMQ(rName, "consumer_timeout = 1800000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mq", regexp.MustCompile(`configuration:+.`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "simple"),
+					resource.TestCheckResourceAttr(resourceName, "data", "consumer_timeout = 1800000\n"),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "RabbitMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "3.11.16"),
+					resource.TestCheckResourceAttr(resourceName, "latest_revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccConfigurationConfig_rabbitMQ(rName, "# Consumer timeout\nconsumer_timeout=1800000"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccConfigurationConfig_rabbitMQ(rName, "consumer_timeout = 900000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data", "consumer_timeout = 900000\n"),
+					resource.TestCheckResourceAttr(resourceName, "latest_revision", "3"),
+				),
+			},
 		},
 	})
 }
@@ -275,6 +336,21 @@ DATA
 `, rName)
 }
 
+func testAccConfigurationConfig_rabbitMQ(rName, data string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_configuration" "test" {
+  description    = "TfAccTest MQ Configuration"
+  name           = %[1]q
+  engine_type    = "RabbitMQ"
+  engine_version = "3.11.16"
+
+  data = <<DATA
+%[2]s
+DATA
+}
+`, rName, data)
+}
+
 func testAccConfigurationConfig_ldapData(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_mq_configuration" "test" {
@@ -0,0 +1,7 @@
+package mq
+
+// Exports for use in tests only.
+var (
+	BrokerInstanceTypeSpecs = brokerInstanceTypeSpecs
+	FlattenBrokerInstances  = flattenBrokerInstances
+)
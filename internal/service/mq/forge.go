@@ -1,8 +1,14 @@
 package mq
 
 import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
 	"regexp"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/beevik/etree"
 )
 
@@ -24,3 +30,85 @@ func CanonicalXML(s string) (string, error) {
 	results := re.ReplaceAllString(rawString, "")
 	return results, nil
 }
+
+// CanonicalRabbitMQConfig re-writes RabbitMQ configuration (rabbitmq.conf) data canonically, used for
+// comparing configurations for logical equivalency. Blank lines and comments are dropped and
+// whitespace around keys and values is removed.
+func CanonicalRabbitMQConfig(s string) string {
+	var lines []string
+
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if k, v, ok := strings.Cut(line, "="); ok {
+			line = strings.TrimSpace(k) + " = " + strings.TrimSpace(v)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ValidConfigurationData returns an error if the configuration data is not valid for the engine type:
+// well-formed XML for ActiveMQ and "key = value" lines for RabbitMQ.
+func ValidConfigurationData(engineType, data string) error {
+	switch strings.ToUpper(engineType) {
+	case mq.EngineTypeActivemq:
+		return validActiveMQConfig(data)
+	case mq.EngineTypeRabbitmq:
+		return validRabbitMQConfig(data)
+	}
+
+	return nil
+}
+
+func validActiveMQConfig(data string) error {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+	var root bool
+
+	for {
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("ActiveMQ configuration data is not well-formed XML: %w", err)
+		}
+
+		if _, ok := token.(xml.StartElement); ok {
+			root = true
+		}
+	}
+
+	if !root {
+		return errors.New("ActiveMQ configuration data is not well-formed XML: no root element")
+	}
+
+	return nil
+}
+
+func validRabbitMQConfig(data string) error {
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		k, v, ok := strings.Cut(line, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+
+		if !ok || k == "" || v == "" || strings.ContainsAny(k, " \t") {
+			return fmt.Errorf("RabbitMQ configuration data line %d (%q) is not of the form \"key = value\"", i+1, line)
+		}
+	}
+
+	return nil
+}
@@ -83,6 +83,126 @@ func TestCanonicalXML(t *testing.T) {
 	}
 }
 
+func TestCanonicalRabbitMQConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name     string
+		Config   string
+		Expected string
+		Equal    bool
+	}{
+		{
+			Name:     "identical",
+			Config:   "consumer_timeout = 1800000\nheartbeat = 60\n",
+			Expected: "consumer_timeout = 1800000\nheartbeat = 60\n",
+			Equal:    true,
+		},
+		{
+			Name:     "whitespace, comments and blank lines",
+			Config:   "# Timeouts\nconsumer_timeout=1800000\n\n  heartbeat   =  60  \n",
+			Expected: "consumer_timeout = 1800000\nheartbeat = 60",
+			Equal:    true,
+		},
+		{
+			Name:     "different value",
+			Config:   "consumer_timeout = 1800000\n",
+			Expected: "consumer_timeout = 900000\n",
+		},
+		{
+			Name:     "different order",
+			Config:   "consumer_timeout = 1800000\nheartbeat = 60\n",
+			Expected: "heartbeat = 60\nconsumer_timeout = 1800000\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfmq.CanonicalRabbitMQConfig(tc.Config) == tfmq.CanonicalRabbitMQConfig(tc.Expected); got != tc.Equal {
+				t.Fatalf("got equal %t, expected %t", got, tc.Equal)
+			}
+		})
+	}
+}
+
+func TestValidConfigurationData(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name        string
+		EngineType  string
+		Data        string
+		ExpectError bool
+	}{
+		{
+			Name:       "ActiveMQ",
+			EngineType: "ActiveMQ",
+			Data:       testAccForgeConfig_testExampleXMLFromMsdn,
+		},
+		{
+			Name:        "ActiveMQ unclosed element",
+			EngineType:  "ACTIVEMQ",
+			Data:        `<broker xmlns="http://activemq.apache.org/schema/core"><plugins></broker>`,
+			ExpectError: true,
+		},
+		{
+			Name:        "ActiveMQ no root element",
+			EngineType:  "ACTIVEMQ",
+			Data:        `<?xml version="1.0"?>`,
+			ExpectError: true,
+		},
+		{
+			Name:        "ActiveMQ RabbitMQ data",
+			EngineType:  "ACTIVEMQ",
+			Data:        "consumer_timeout = 1800000\n",
+			ExpectError: true,
+		},
+		{
+			Name:       "RabbitMQ",
+			EngineType: "RabbitMQ",
+			Data:       "# Timeouts\nconsumer_timeout = 1800000\n\nheartbeat=60\n",
+		},
+		{
+			Name:        "RabbitMQ missing value",
+			EngineType:  "RABBITMQ",
+			Data:        "consumer_timeout =\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "RabbitMQ missing separator",
+			EngineType:  "RABBITMQ",
+			Data:        "consumer_timeout 1800000\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "RabbitMQ ActiveMQ data",
+			EngineType:  "RABBITMQ",
+			Data:        `<broker xmlns="http://activemq.apache.org/schema/core"></broker>`,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfmq.ValidConfigurationData(tc.EngineType, tc.Data)
+
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 const testAccForgeConfig_testExampleXMLFromMsdn = `
 <?xml version="1.0"?>
 <purchaseOrder xmlns="http://tempuri.org/po.xsd" orderDate="1999-10-20">
@@ -25,6 +25,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceBroker,
 			TypeName: "aws_mq_broker",
 		},
+		{
+			Factory:  DataSourceBrokerInstanceTypeOffering,
+			TypeName: "aws_mq_broker_instance_type_offering",
+		},
 		{
 			Factory:  DataSourceBrokerInstanceTypeOfferings,
 			TypeName: "aws_mq_broker_instance_type_offerings",
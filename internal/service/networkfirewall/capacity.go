@@ -0,0 +1,139 @@
+package networkfirewall
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ruleGroupCapacityDiagnostics returns a warning if the rule group's configured capacity appears
+// to be less than its rules consume. It is a warning rather than an error as the estimate is approximate.
+// "rules" takes precedence over "rule_group", which is Computed from it when configured.
+func ruleGroupCapacityDiagnostics(capacity int, tfRuleGroup []interface{}, rules string) diag.Diagnostics {
+	var ruleGroup *networkfirewall.RuleGroup
+
+	if rules == "" && len(tfRuleGroup) > 0 && tfRuleGroup[0] != nil {
+		ruleGroup = expandRuleGroup(tfRuleGroup[0].(map[string]interface{}))
+	}
+
+	estimate := estimateRuleGroupCapacity(ruleGroup, rules)
+
+	if estimate <= capacity {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "NetworkFirewall Rule Group capacity may be insufficient",
+			Detail:   fmt.Sprintf("capacity is %d, but the configured rules are estimated to consume at least %d. The rule group cannot be created or updated with rules that exceed its capacity, and capacity can only be changed by replacing the rule group.", capacity, estimate),
+		},
+	}
+}
+
+// ruleVariablesIPSetsCapacityWarningPercent is the percentage of a rule group's capacity
+// above which the CIDR blocks of its IP set variables are reported.
+const ruleVariablesIPSetsCapacityWarningPercent = 80
+
+// ruleVariablesIPSetsCapacityDiagnostics returns a warning if the CIDR blocks defined by the rule group's IP set variables,
+// in the form accepted by expandIPSets, approach or exceed its capacity. A rule that references an IP set variable
+// may consume capacity for each of the CIDR blocks in the set, which estimateRuleGroupCapacity doesn't account for.
+func ruleVariablesIPSetsCapacityDiagnostics(capacity int, tfList []interface{}) diag.Diagnostics {
+	var estimate int
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			estimate += ipSetDefinitionLen(tfMap)
+		}
+	}
+
+	if estimate*100 <= capacity*ruleVariablesIPSetsCapacityWarningPercent {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "NetworkFirewall Rule Group IP set variables may exceed capacity",
+			Detail:   fmt.Sprintf("capacity is %d, but IP set variables define %d CIDR blocks, which rules that reference them are estimated to consume. Consider splitting the CIDR blocks across several rule groups, or aggregating them into fewer, larger CIDR blocks.", capacity, estimate),
+		},
+	}
+}
+
+// estimateRuleGroupCapacity returns a lower bound on the capacity consumed by a rule group's rules,
+// following https://docs.aws.amazon.com/network-firewall/latest/developerguide/rule-group-capacity.html.
+// Rule variables and IP set references that expand to several values are counted once,
+// so the actual consumption may be higher but is never lower.
+func estimateRuleGroupCapacity(ruleGroup *networkfirewall.RuleGroup, rules string) int {
+	capacity := estimateRulesStringCapacity(rules)
+
+	if ruleGroup == nil || ruleGroup.RulesSource == nil {
+		return capacity
+	}
+
+	rulesSource := ruleGroup.RulesSource
+
+	if v := rulesSource.RulesSourceList; v != nil {
+		capacity += len(v.Targets) * atLeastOne(len(v.TargetTypes))
+	}
+
+	capacity += estimateRulesStringCapacity(aws.StringValue(rulesSource.RulesString))
+	capacity += len(rulesSource.StatefulRules)
+
+	if v := rulesSource.StatelessRulesAndCustomActions; v != nil {
+		for _, rule := range v.StatelessRules {
+			capacity += estimateStatelessRuleCapacity(rule)
+		}
+	}
+
+	return capacity
+}
+
+// estimateRulesStringCapacity counts one unit per Suricata compatible rule, ignoring blank lines and comments.
+// A rule continued on the next line with a trailing backslash is counted once.
+func estimateRulesStringCapacity(rules string) int {
+	var capacity int
+	var continued bool
+
+	for _, line := range strings.Split(rules, "\n") {
+		line = strings.TrimSpace(line)
+		continuation := continued
+		continued = strings.HasSuffix(line, "\\")
+
+		if continuation || line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		capacity++
+	}
+
+	return capacity
+}
+
+// estimateStatelessRuleCapacity returns the product of the number of values in each of the rule's match settings.
+// A match setting with no values counts as one.
+func estimateStatelessRuleCapacity(rule *networkfirewall.StatelessRule) int {
+	if rule == nil || rule.RuleDefinition == nil || rule.RuleDefinition.MatchAttributes == nil {
+		return 1
+	}
+
+	matchAttributes := rule.RuleDefinition.MatchAttributes
+
+	return atLeastOne(len(matchAttributes.Protocols)) *
+		atLeastOne(len(matchAttributes.Sources)) *
+		atLeastOne(len(matchAttributes.SourcePorts)) *
+		atLeastOne(len(matchAttributes.Destinations)) *
+		atLeastOne(len(matchAttributes.DestinationPorts)) *
+		atLeastOne(len(matchAttributes.TCPFlags))
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+
+	return n
+}
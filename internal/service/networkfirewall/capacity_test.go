@@ -0,0 +1,152 @@
+package networkfirewall
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestEstimateRuleGroupCapacity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		ruleGroup *networkfirewall.RuleGroup
+		rules     string
+		expected  int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "rules",
+			rules:    "# comment\npass tcp any any -> any any (sid:1;)\n\ndrop udp any any -> any any (sid:2;)\n",
+			expected: 2,
+		},
+		{
+			name:     "rules with continuation lines",
+			rules:    "pass tcp any any -> any any ( \\\n  msg:\"multi-line\"; \\\n  sid:1;)\n# comment\ndrop udp any any -> any any (sid:2;)\n",
+			expected: 2,
+		},
+		{
+			name: "rules string",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					RulesString: aws.String("pass tcp any any -> any any (sid:1;)"),
+				},
+			},
+			expected: 1,
+		},
+		{
+			name: "rules source list",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					RulesSourceList: &networkfirewall.RulesSourceList{
+						GeneratedRulesType: aws.String(networkfirewall.GeneratedRulesTypeDenylist),
+						TargetTypes:        aws.StringSlice([]string{networkfirewall.TargetTypeHttpHost, networkfirewall.TargetTypeTlsSni}),
+						Targets:            aws.StringSlice([]string{"example.com", "example.org", "example.net"}),
+					},
+				},
+			},
+			expected: 6,
+		},
+		{
+			name: "stateful rules",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					StatefulRules: []*networkfirewall.StatefulRule{{}, {}, {}},
+				},
+			},
+			expected: 3,
+		},
+		{
+			name: "stateless rules",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					StatelessRulesAndCustomActions: &networkfirewall.StatelessRulesAndCustomActions{
+						StatelessRules: []*networkfirewall.StatelessRule{
+							{
+								RuleDefinition: &networkfirewall.RuleDefinition{
+									MatchAttributes: &networkfirewall.MatchAttributes{
+										Destinations: []*networkfirewall.Address{{}, {}},
+										Protocols:    aws.Int64Slice([]int64{6, 17}),
+										Sources:      []*networkfirewall.Address{{}},
+									},
+								},
+							},
+							{
+								RuleDefinition: &networkfirewall.RuleDefinition{
+									MatchAttributes: &networkfirewall.MatchAttributes{
+										DestinationPorts: []*networkfirewall.PortRange{{}, {}, {}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: 7,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := estimateRuleGroupCapacity(testCase.ruleGroup, testCase.rules), testCase.expected; got != want {
+				t.Errorf("estimateRuleGroupCapacity = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRuleGroupCapacityDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	rules := "pass tcp any any -> any any (sid:1;)\npass udp any any -> any any (sid:2;)"
+
+	if diags := ruleGroupCapacityDiagnostics(2, nil, rules); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics with sufficient capacity: %v", diags)
+	}
+
+	diags := ruleGroupCapacityDiagnostics(1, nil, rules)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic with insufficient capacity, got %d", len(diags))
+	}
+
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning, got severity %v", diags[0].Severity)
+	}
+
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+}
+
+func TestRuleVariablesIPSetsCapacityDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	ipSets := []interface{}{testIPSet("HOME_NET", 60), testIPSet("EXTERNAL_NET", 20)}
+
+	if diags := ruleVariablesIPSetsCapacityDiagnostics(100, ipSets); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics at the threshold: %v", diags)
+	}
+
+	if diags := ruleVariablesIPSetsCapacityDiagnostics(100, nil); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics without IP sets: %v", diags)
+	}
+
+	diags := ruleVariablesIPSetsCapacityDiagnostics(99, ipSets)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic above the threshold, got %d", len(diags))
+	}
+
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning, got severity %v", diags[0].Severity)
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkfirewall/networkfirewalliface"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindLoggingConfiguration returns the LoggingConfigurationOutput from a call to DescribeLoggingConfigurationWithContext
@@ -55,3 +57,108 @@ func FindResourcePolicy(ctx context.Context, conn *networkfirewall.NetworkFirewa
 	}
 	return output.Policy, nil
 }
+
+// FindFirewalls returns the metadata of all the firewalls matching the input, following pagination.
+func FindFirewalls(ctx context.Context, conn networkfirewalliface.NetworkFirewallAPI, input *networkfirewall.ListFirewallsInput) ([]*networkfirewall.FirewallMetadata, error) {
+	var output []*networkfirewall.FirewallMetadata
+
+	err := listFirewallsPages(ctx, conn, input, func(page *networkfirewall.ListFirewallsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Firewalls {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindFirewallPolicies returns the metadata of all the firewall policies matching the input, following pagination.
+func FindFirewallPolicies(ctx context.Context, conn networkfirewalliface.NetworkFirewallAPI, input *networkfirewall.ListFirewallPoliciesInput) ([]*networkfirewall.FirewallPolicyMetadata, error) {
+	var output []*networkfirewall.FirewallPolicyMetadata
+
+	err := listFirewallPoliciesPages(ctx, conn, input, func(page *networkfirewall.ListFirewallPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FirewallPolicies {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRuleGroups returns the metadata of all the rule groups matching the input, following pagination.
+// The input's Scope selects between the account's own rule groups (ACCOUNT, the default) and AWS managed rule groups (MANAGED).
+func FindRuleGroups(ctx context.Context, conn networkfirewalliface.NetworkFirewallAPI, input *networkfirewall.ListRuleGroupsInput) ([]*networkfirewall.RuleGroupMetadata, error) {
+	var output []*networkfirewall.RuleGroupMetadata
+
+	err := listRuleGroupsPages(ctx, conn, input, func(page *networkfirewall.ListRuleGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RuleGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRuleGroupByName returns the metadata of the rule group with the given name in the given scope.
+// ruleGroupType may be empty to match rule groups of either type, in which case an error is returned
+// if both a stateful and a stateless rule group have the name.
+func FindRuleGroupByName(ctx context.Context, conn networkfirewalliface.NetworkFirewallAPI, name, ruleGroupType, scope string) (*networkfirewall.RuleGroupMetadata, error) {
+	input := &networkfirewall.ListRuleGroupsInput{}
+	if ruleGroupType != "" {
+		input.Type = aws.String(ruleGroupType)
+	}
+	if scope != "" {
+		input.Scope = aws.String(scope)
+	}
+
+	ruleGroups, err := FindRuleGroups(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*networkfirewall.RuleGroupMetadata
+
+	for _, v := range ruleGroups {
+		if aws.StringValue(v.Name) == name {
+			output = append(output, v)
+		}
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
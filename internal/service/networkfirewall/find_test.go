@@ -0,0 +1,191 @@
+package networkfirewall_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkfirewall/networkfirewalliface"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// mockListRuleGroupsConn serves ListRuleGroups pages keyed by their index, used as the pagination token.
+type mockListRuleGroupsConn struct {
+	networkfirewalliface.NetworkFirewallAPI
+
+	pages  map[string][]*networkfirewall.RuleGroupMetadata
+	inputs []networkfirewall.ListRuleGroupsInput
+}
+
+func (m *mockListRuleGroupsConn) ListRuleGroupsWithContext(ctx aws.Context, input *networkfirewall.ListRuleGroupsInput, _ ...request.Option) (*networkfirewall.ListRuleGroupsOutput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.inputs = append(m.inputs, *input)
+
+	scope := aws.StringValue(input.Scope)
+	if scope == "" {
+		scope = networkfirewall.ResourceManagedStatusAccount
+	}
+	pages := m.pages[scope]
+
+	i := 0
+	if v := aws.StringValue(input.NextToken); v != "" {
+		i, _ = strconv.Atoi(v)
+	}
+
+	output := &networkfirewall.ListRuleGroupsOutput{}
+	if i < len(pages) {
+		output.RuleGroups = []*networkfirewall.RuleGroupMetadata{pages[i]}
+	}
+	if i+1 < len(pages) {
+		output.NextToken = aws.String(strconv.Itoa(i + 1))
+	}
+
+	return output, nil
+}
+
+func testRuleGroupMetadata(name, ruleGroupType string) *networkfirewall.RuleGroupMetadata {
+	return &networkfirewall.RuleGroupMetadata{
+		Arn:  aws.String("arn:aws:network-firewall:us-west-2:123456789012:" + ruleGroupType + "-rulegroup/" + name), //lintignore:AWSAT003,AWSAT005
+		Name: aws.String(name),
+	}
+}
+
+func newMockListRuleGroupsConn() *mockListRuleGroupsConn {
+	return &mockListRuleGroupsConn{
+		pages: map[string][]*networkfirewall.RuleGroupMetadata{
+			networkfirewall.ResourceManagedStatusAccount: {
+				testRuleGroupMetadata("first", "stateful"),
+				testRuleGroupMetadata("second", "stateful"),
+				testRuleGroupMetadata("second", "stateless"),
+				testRuleGroupMetadata("third", "stateless"),
+			},
+			networkfirewall.ResourceManagedStatusManaged: {
+				{
+					Arn:  aws.String("arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/ThreatSignaturesBotnetStrictOrder"), //lintignore:AWSAT003,AWSAT005
+					Name: aws.String("ThreatSignaturesBotnetStrictOrder"),
+				},
+			},
+		},
+	}
+}
+
+func TestFindRuleGroups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := newMockListRuleGroupsConn()
+
+	output, err := tfnetworkfirewall.FindRuleGroups(ctx, conn, &networkfirewall.ListRuleGroupsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(output), 4; got != expected {
+		t.Errorf("got %d rule groups, expected %d", got, expected)
+	}
+
+	if got, expected := len(conn.inputs), 4; got != expected {
+		t.Errorf("got %d ListRuleGroups calls, expected %d", got, expected)
+	}
+}
+
+func TestFindRuleGroups_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := tfnetworkfirewall.FindRuleGroups(ctx, newMockListRuleGroupsConn(), &networkfirewall.ListRuleGroupsInput{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestFindRuleGroupByName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Name          string
+		Type          string
+		Scope         string
+		ExpectedARN   string
+		ExpectedError error
+	}{
+		{
+			TestName:    "last page",
+			Name:        "third",
+			Scope:       networkfirewall.ResourceManagedStatusAccount,
+			ExpectedARN: "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/third", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "not found",
+			Name:          "fourth",
+			Scope:         networkfirewall.ResourceManagedStatusAccount,
+			ExpectedError: tfresource.ErrEmptyResult,
+		},
+		{
+			TestName:      "ambiguous",
+			Name:          "second",
+			Scope:         networkfirewall.ResourceManagedStatusAccount,
+			ExpectedError: tfresource.ErrTooManyResults,
+		},
+		{
+			TestName:    "managed",
+			Name:        "ThreatSignaturesBotnetStrictOrder",
+			Scope:       networkfirewall.ResourceManagedStatusManaged,
+			ExpectedARN: "arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/ThreatSignaturesBotnetStrictOrder", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "managed not in account scope",
+			Name:          "ThreatSignaturesBotnetStrictOrder",
+			Scope:         networkfirewall.ResourceManagedStatusAccount,
+			ExpectedError: tfresource.ErrEmptyResult,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn := newMockListRuleGroupsConn()
+
+			output, err := tfnetworkfirewall.FindRuleGroupByName(ctx, conn, testCase.Name, testCase.Type, testCase.Scope)
+
+			if testCase.ExpectedError != nil {
+				if !errors.Is(err, testCase.ExpectedError) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.StringValue(output.Arn); got != testCase.ExpectedARN {
+				t.Errorf("got ARN %s, expected %s", got, testCase.ExpectedARN)
+			}
+
+			for _, input := range conn.inputs {
+				if got := aws.StringValue(input.Scope); got != testCase.Scope {
+					t.Errorf("got scope %s, expected %s", got, testCase.Scope)
+				}
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -233,6 +234,7 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	updateToken := d.Get("update_token").(string)
 
@@ -319,68 +321,124 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		updateToken = aws.StringValue(output.UpdateToken)
 	}
 
-	if d.HasChange("subnet_change_protection") {
-		input := &networkfirewall.UpdateSubnetChangeProtectionInput{
-			FirewallArn:            aws.String(d.Id()),
-			SubnetChangeProtection: aws.Bool(d.Get("subnet_change_protection").(bool)),
-			UpdateToken:            aws.String(updateToken),
+	if d.HasChange("subnet_mapping") {
+		o, n := d.GetChange("subnet_change_protection")
+		oldSubnetChangeProtection, newSubnetChangeProtection := o.(bool), n.(bool)
+
+		// Subnet associations can't be changed while subnet change protection is enabled.
+		// Lift the protection for the duration of the change and apply the configured value afterwards.
+		if oldSubnetChangeProtection {
+			var err error
+			updateToken, err = updateFirewallSubnetChangeProtection(ctx, conn, d.Id(), updateToken, false)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling NetworkFirewall Firewall (%s) subnet change protection: %s", d.Id(), err)
+			}
+		}
+
+		o, n = d.GetChange("subnet_mapping")
+
+		if err := updateFirewallSubnetMappings(ctx, conn, d.Id(), updateToken, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall (%s) subnet mappings: %s", d.Id(), err)
+
+			if oldSubnetChangeProtection {
+				// Some subnets may have been (dis)associated already so the update token is stale.
+				if _, err := updateFirewallSubnetChangeProtection(ctx, conn, d.Id(), "", true); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "re-enabling NetworkFirewall Firewall (%s) subnet change protection: %s", d.Id(), err)
+				}
+			}
+
+			return diags
 		}
 
-		output, err := conn.UpdateSubnetChangeProtectionWithContext(ctx, input)
+		if newSubnetChangeProtection {
+			if _, err := updateFirewallSubnetChangeProtection(ctx, conn, d.Id(), "", true); err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling NetworkFirewall Firewall (%s) subnet change protection: %s", d.Id(), err)
+			}
+
+			if oldSubnetChangeProtection {
+				diags = sdkdiag.AppendWarningf(diags, "NetworkFirewall Firewall (%s) subnet change protection was temporarily disabled while updating subnet mappings", d.Id())
+			}
+		}
+	} else if d.HasChange("subnet_change_protection") {
+		if _, err := updateFirewallSubnetChangeProtection(ctx, conn, d.Id(), updateToken, d.Get("subnet_change_protection").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall (%s) subnet change protection: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFirewallRead(ctx, d, meta)...)
+}
+
+// updateFirewallSubnetChangeProtection sets the firewall's subnet change protection and returns the new update token.
+// If updateToken is empty the firewall's current update token is used.
+func updateFirewallSubnetChangeProtection(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn, updateToken string, subnetChangeProtection bool) (string, error) {
+	if updateToken == "" {
+		output, err := FindFirewallByARN(ctx, conn, arn)
 
 		if err != nil {
-			return diag.Errorf("updating NetworkFirewall Firewall (%s) subnet change protection: %s", d.Id(), err)
+			return "", err
 		}
 
 		updateToken = aws.StringValue(output.UpdateToken)
 	}
 
-	if d.HasChange("subnet_mapping") {
-		o, n := d.GetChange("subnet_mapping")
-		subnetsToRemove, subnetsToAdd := subnetMappingsDiff(o.(*schema.Set), n.(*schema.Set))
-
-		if len(subnetsToAdd) > 0 {
-			input := &networkfirewall.AssociateSubnetsInput{
-				FirewallArn:    aws.String(d.Id()),
-				SubnetMappings: subnetsToAdd,
-				UpdateToken:    aws.String(updateToken),
-			}
+	input := &networkfirewall.UpdateSubnetChangeProtectionInput{
+		FirewallArn:            aws.String(arn),
+		SubnetChangeProtection: aws.Bool(subnetChangeProtection),
+		UpdateToken:            aws.String(updateToken),
+	}
 
-			_, err := conn.AssociateSubnetsWithContext(ctx, input)
+	output, err := conn.UpdateSubnetChangeProtectionWithContext(ctx, input)
 
-			if err != nil {
-				return diag.Errorf("associating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
-			}
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.UpdateToken), nil
+}
 
-			updateToken, err = waitFirewallUpdated(ctx, conn, d.Id())
+func updateFirewallSubnetMappings(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn, updateToken string, o, n *schema.Set) error {
+	subnetsToRemove, subnetsToAdd := subnetMappingsDiff(o, n)
 
-			if err != nil {
-				return diag.Errorf("waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
-			}
+	if len(subnetsToAdd) > 0 {
+		input := &networkfirewall.AssociateSubnetsInput{
+			FirewallArn:    aws.String(arn),
+			SubnetMappings: subnetsToAdd,
+			UpdateToken:    aws.String(updateToken),
 		}
 
-		if len(subnetsToRemove) > 0 {
-			input := &networkfirewall.DisassociateSubnetsInput{
-				FirewallArn: aws.String(d.Id()),
-				SubnetIds:   aws.StringSlice(subnetsToRemove),
-				UpdateToken: aws.String(updateToken),
-			}
+		_, err := conn.AssociateSubnetsWithContext(ctx, input)
 
-			_, err := conn.DisassociateSubnetsWithContext(ctx, input)
+		if err != nil {
+			return fmt.Errorf("associating subnets: %w", err)
+		}
 
-			if err == nil {
-				/*updateToken*/ _, err = waitFirewallUpdated(ctx, conn, d.Id())
+		updateToken, err = waitFirewallUpdated(ctx, conn, arn)
 
-				if err != nil {
-					return diag.Errorf("waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
-				}
-			} else if !tfawserr.ErrMessageContains(err, networkfirewall.ErrCodeInvalidRequestException, "inaccessible") {
-				return diag.Errorf("disassociating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
+		if err != nil {
+			return fmt.Errorf("waiting for update: %w", err)
+		}
+	}
+
+	if len(subnetsToRemove) > 0 {
+		input := &networkfirewall.DisassociateSubnetsInput{
+			FirewallArn: aws.String(arn),
+			SubnetIds:   aws.StringSlice(subnetsToRemove),
+			UpdateToken: aws.String(updateToken),
+		}
+
+		_, err := conn.DisassociateSubnetsWithContext(ctx, input)
+
+		if err == nil {
+			if _, err := waitFirewallUpdated(ctx, conn, arn); err != nil {
+				return fmt.Errorf("waiting for update: %w", err)
 			}
+		} else if !tfawserr.ErrMessageContains(err, networkfirewall.ErrCodeInvalidRequestException, "inaccessible") {
+			return fmt.Errorf("disassociating subnets: %w", err)
 		}
 	}
 
-	return resourceFirewallRead(ctx, d, meta)
+	return nil
 }
 
 func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
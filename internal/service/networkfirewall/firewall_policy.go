@@ -2,6 +2,7 @@ package networkfirewall
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -152,11 +153,41 @@ func ResourceFirewallPolicy() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("firewall_policy.0.stateful_engine_options.0.rule_order", d)
 			},
+			resourceFirewallPolicyCustomizeDiffStatefulRuleGroupReferences,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+func resourceFirewallPolicyCustomizeDiffStatefulRuleGroupReferences(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const (
+		key          = "firewall_policy.0.stateful_rule_group_reference"
+		ruleOrderKey = "firewall_policy.0.stateful_engine_options.0.rule_order"
+	)
+
+	if !d.NewValueKnown(ruleOrderKey) {
+		return nil
+	}
+
+	v, ok := d.Get(key).(*schema.Set)
+
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	findManaged := func(name string) (*networkfirewall.RuleGroupMetadata, error) {
+		conn := meta.(*conns.AWSClient).NetworkFirewallConn()
+
+		return FindRuleGroupByName(ctx, conn, name, networkfirewall.RuleGroupTypeStateful, networkfirewall.ResourceManagedStatusManaged)
+	}
+
+	if err := validStatefulRuleGroupReferences(v.List(), d.Get(ruleOrderKey).(string), findManaged); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/listpages/main.go -ListOps=ListFirewallPolicies,ListFirewalls,ListRuleGroups
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
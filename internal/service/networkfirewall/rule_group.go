@@ -2,13 +2,19 @@ package networkfirewall
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -32,7 +38,7 @@ func ResourceRuleGroup() *schema.Resource {
 		DeleteWithoutTimeout: resourceRuleGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRuleGroupImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +77,6 @@ func ResourceRuleGroup() *schema.Resource {
 									"ip_set_references": {
 										Type:     schema.TypeSet,
 										Optional: true,
-										MaxItems: 5,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"ip_set_reference": {
@@ -122,6 +127,7 @@ func ResourceRuleGroup() *schema.Resource {
 												"target_types": {
 													Type:     schema.TypeSet,
 													Required: true,
+													MinItems: 1,
 													Elem: &schema.Schema{
 														Type:         schema.TypeString,
 														ValidateFunc: validation.StringInSlice(networkfirewall.TargetType_Values(), false),
@@ -130,7 +136,11 @@ func ResourceRuleGroup() *schema.Resource {
 												"targets": {
 													Type:     schema.TypeSet,
 													Required: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validRulesSourceListTarget,
+													},
 												},
 											},
 										},
@@ -245,7 +255,7 @@ func ResourceRuleGroup() *schema.Resource {
 																								"address_definition": {
 																									Type:         schema.TypeString,
 																									Required:     true,
-																									ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+																									ValidateFunc: validStatelessRuleAddressDefinition,
 																								},
 																							},
 																						},
@@ -279,7 +289,7 @@ func ResourceRuleGroup() *schema.Resource {
 																								"address_definition": {
 																									Type:         schema.TypeString,
 																									Required:     true,
-																									ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+																									ValidateFunc: validStatelessRuleAddressDefinition,
 																								},
 																							},
 																						},
@@ -368,7 +378,10 @@ func ResourceRuleGroup() *schema.Resource {
 															"definition": {
 																Type:     schema.TypeSet,
 																Required: true,
-																Elem:     &schema.Schema{Type: schema.TypeString},
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: verify.ValidIPv46CIDRNetworkAddress,
+																},
 															},
 														},
 													},
@@ -430,6 +443,11 @@ func ResourceRuleGroup() *schema.Resource {
 			"rules": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
+			},
+			"rules_string_output": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -450,11 +468,412 @@ func ResourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			resourceRuleGroupCustomizeDiffIPSetReferences,
+			resourceRuleGroupCustomizeDiffStatelessRulePriorities,
+			resourceRuleGroupCustomizeDiffStatelessRuleActions,
+			resourceRuleGroupCustomizeDiffStatefulRuleProtocols,
+			resourceRuleGroupCustomizeDiffStatefulRuleOptions,
+			resourceRuleGroupCustomizeDiffRuleVariables,
+			resourceRuleGroupCustomizeDiffRulesStringVariables,
+			resourceRuleGroupCustomizeDiffRulesSourceList,
+			customdiff.ComputedIf("rules_string_output", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges("rule_group", "rules")
+			}),
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+// preserveStatefulRuleHeaderDirectionAny keeps the ANY direction of the stateful rule headers in `oldTfRuleGroup`
+// where the rule group read back in `tfRuleGroup` has the FORWARD direction that some stateful engine versions return for it,
+// as long as the rest of the header is unchanged. Unlike suppressing the difference, this still allows changing FORWARD to ANY.
+func preserveStatefulRuleHeaderDirectionAny(oldTfRuleGroup, tfRuleGroup []interface{}) {
+	oldHeaders := ruleGroupStatefulRuleHeaders(oldTfRuleGroup)
+
+	for i, header := range ruleGroupStatefulRuleHeaders(tfRuleGroup) {
+		if i >= len(oldHeaders) || header == nil || oldHeaders[i] == nil {
+			continue
+		}
+
+		if header["direction"] != networkfirewall.StatefulRuleDirectionForward || oldHeaders[i]["direction"] != networkfirewall.StatefulRuleDirectionAny {
+			continue
+		}
+
+		unchanged := true
+
+		for _, key := range []string{"destination", "destination_port", "protocol", "source", "source_port"} {
+			if header[key] != oldHeaders[i][key] {
+				unchanged = false
+				break
+			}
+		}
+
+		if unchanged {
+			header["direction"] = networkfirewall.StatefulRuleDirectionAny
+		}
+	}
+}
+
+// ruleGroupStatefulRuleHeaders returns the header of each stateful rule in "rule_group", or nil for a rule without one.
+func ruleGroupStatefulRuleHeaders(tfRuleGroup []interface{}) []map[string]interface{} {
+	if len(tfRuleGroup) == 0 || tfRuleGroup[0] == nil {
+		return nil
+	}
+
+	tfList, ok := tfRuleGroup[0].(map[string]interface{})["rules_source"].([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfList, ok = tfList[0].(map[string]interface{})["stateful_rule"].([]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	headers := make([]map[string]interface{}, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			headers[i] = v[0].(map[string]interface{})
+		}
+	}
+
+	return headers
+}
+
+const (
+	ruleGroupIPSetReferencesMaxItems = 5
+	// AWS managed rule groups are owned by this pseudo account, e.g.
+	// arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/MalwareDomainsActionOrder.
+	ruleGroupManagedAccountID = "aws-managed"
+)
+
+// isManagedRuleGroupARN returns whether the specified ARN is that of an AWS managed rule group.
+func isManagedRuleGroupARN(s string) bool {
+	v, err := arn.Parse(s)
+
+	return err == nil && v.AccountID == ruleGroupManagedAccountID
+}
+
+// managedRuleGroupDiagnostics returns an error if the specified ARN is that of an AWS managed rule group.
+// Managed rule groups are read-only, so every create, update or delete of one would fail.
+func managedRuleGroupDiagnostics(ruleGroupARN string) diag.Diagnostics {
+	if !isManagedRuleGroupARN(ruleGroupARN) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "NetworkFirewall Rule Group is AWS managed",
+			Detail:   fmt.Sprintf("%s is an AWS managed rule group, which is read-only and can't be managed by this resource. Reference it by ARN in an aws_networkfirewall_firewall_policy's stateful_rule_group_reference instead.", ruleGroupARN),
+		},
+	}
+}
+
+func resourceRuleGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Rule groups owned by the account can also be imported by name.
+	if !arn.IsARN(d.Id()) {
+		conn := meta.(*conns.AWSClient).NetworkFirewallConn()
+
+		ruleGroup, err := FindRuleGroupByName(ctx, conn, d.Id(), "", networkfirewall.ResourceManagedStatusAccount)
+
+		if errors.Is(err, tfresource.ErrTooManyResults) {
+			return nil, fmt.Errorf("importing NetworkFirewall Rule Group (%s): both a stateful and a stateless rule group have this name, import by ARN instead", d.Id())
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("importing NetworkFirewall Rule Group (%s): %w", d.Id(), err)
+		}
+
+		d.SetId(aws.StringValue(ruleGroup.Arn))
+	}
+
+	if diags := managedRuleGroupDiagnostics(d.Id()); diags.HasError() {
+		return nil, fmt.Errorf("importing NetworkFirewall Rule Group (%s): %s", d.Id(), diags[0].Detail)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceRuleGroupCustomizeDiffIPSetReferences enforces the maximum number of IP set references
+// in place of a schema MaxItems, so that the error identifies the references supplied.
+// Unlike MaxItems, this is not checked by `terraform validate`.
+func resourceRuleGroupCustomizeDiffIPSetReferences(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.Get("rule_group.0.reference_sets.0.ip_set_references").(*schema.Set)
+
+	if !ok || v.Len() <= ruleGroupIPSetReferencesMaxItems {
+		return nil
+	}
+
+	var keys []string
+
+	for _, tfMapRaw := range v.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			keys = append(keys, v)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return fmt.Errorf("rule_group.0.reference_sets.0.ip_set_references: %d IP set references supplied (keys: %s), but at most %d are allowed", v.Len(), strings.Join(keys, ", "), ruleGroupIPSetReferencesMaxItems)
+}
+
+func resourceRuleGroupCustomizeDiffStatelessRulePriorities(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const key = "rule_group.0.rules_source.0.stateless_rules_and_custom_actions.0.stateless_rule"
+
+	// Priorities may not be known until apply.
+	if !d.NewValueKnown(key) {
+		return nil
+	}
+
+	v, ok := d.Get(key).(*schema.Set)
+
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	if err := validStatelessRulePriorities(v.List()); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+// resourceRuleGroupCustomizeDiffStatelessRuleActions rejects stateless rule actions that are neither
+// a standard action nor a defined custom action, such as a misspelt "aws:drop".
+func resourceRuleGroupCustomizeDiffStatelessRuleActions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const key = "rule_group.0.rules_source.0.stateless_rules_and_custom_actions"
+
+	if !d.NewValueKnown(key) {
+		return nil
+	}
+
+	v, ok := d.Get(key).([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap := v[0].(map[string]interface{})
+	statelessRules, ok := tfMap["stateless_rule"].(*schema.Set)
+
+	if !ok || statelessRules.Len() == 0 {
+		return nil
+	}
+
+	var customActions []interface{}
+
+	if v, ok := tfMap["custom_action"].(*schema.Set); ok {
+		customActions = v.List()
+	}
+
+	if err := validStatelessRuleActions(statelessRules.List(), customActions); err != nil {
+		return fmt.Errorf("%s.0.stateless_rule: %w", key, err)
+	}
+
+	return nil
+}
+
+func resourceRuleGroupCustomizeDiffStatefulRuleProtocols(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const (
+		key          = "rule_group.0.rules_source.0.stateful_rule"
+		ruleOrderKey = "rule_group.0.stateful_rule_options.0.rule_order"
+	)
+
+	if !d.NewValueKnown(key) || !d.NewValueKnown(ruleOrderKey) {
+		return nil
+	}
+
+	v, ok := d.Get(key).([]interface{})
+
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	if err := validStatefulRuleProtocols(v, d.Get(ruleOrderKey).(string)); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+// resourceRuleGroupCustomizeDiffStatefulRuleOptions validates the settings of stateful rule options,
+// such as flowbits, whose malformed settings the API only reports as an error on apply.
+func resourceRuleGroupCustomizeDiffStatefulRuleOptions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const key = "rule_group.0.rules_source.0.stateful_rule"
+
+	if !d.NewValueKnown(key) {
+		return nil
+	}
+
+	v, ok := d.Get(key).([]interface{})
+
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	if err := validStatefulRuleOptions(v); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+// resourceRuleGroupCustomizeDiffRuleVariables rejects rule variables in stateless rule groups and invalid IP set definitions,
+// which the API only reports as an error on apply. IP sets that approach the capacity are reported by a warning on apply instead.
+func resourceRuleGroupCustomizeDiffRuleVariables(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("type").(string) == networkfirewall.RuleGroupTypeStateless {
+		if v, ok := d.Get("rule_group.0.rule_variables").([]interface{}); ok && len(v) > 0 {
+			return fmt.Errorf("rule_group.0.rule_variables: rule variables can only be specified for %s rule groups", networkfirewall.RuleGroupTypeStateful)
+		}
+
+		return nil
+	}
+
+	if !d.NewValueKnown("rule_group.0.rule_variables") {
+		return nil
+	}
+
+	tfList := ruleGroupRuleVariablesIPSets(d.Get("rule_group").([]interface{}))
+
+	if err := validRuleVariablesIPSets(tfList); err != nil {
+		return fmt.Errorf("rule_group.0.rule_variables.0.ip_sets: %w", err)
+	}
+
+	return nil
+}
+
+// ruleGroupRuleVariablesIPSets returns the configured IP set variables, if any, from "rule_group".
+func ruleGroupRuleVariablesIPSets(tfRuleGroup []interface{}) []interface{} {
+	return ruleGroupRuleVariables(tfRuleGroup, "ip_sets")
+}
+
+// ruleGroupRuleVariablesPortSets returns the configured port set variables, if any, from "rule_group".
+func ruleGroupRuleVariablesPortSets(tfRuleGroup []interface{}) []interface{} {
+	return ruleGroupRuleVariables(tfRuleGroup, "port_sets")
+}
+
+func ruleGroupRuleVariables(tfRuleGroup []interface{}, key string) []interface{} {
+	if len(tfRuleGroup) == 0 || tfRuleGroup[0] == nil {
+		return nil
+	}
+
+	tfList, ok := tfRuleGroup[0].(map[string]interface{})["rule_variables"].([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	v, ok := tfList[0].(map[string]interface{})[key].(*schema.Set)
+
+	if !ok {
+		return nil
+	}
+
+	return v.List()
+}
+
+// resourceRuleGroupCustomizeDiffRulesStringVariables rejects references to undefined rule variables in rules_string,
+// which the API only rejects after the whole rule group has been uploaded.
+func resourceRuleGroupCustomizeDiffRulesStringVariables(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("type").(string) != networkfirewall.RuleGroupTypeStateful {
+		return nil
+	}
+
+	const key = "rule_group.0.rules_source.0.rules_string"
+
+	if !d.NewValueKnown(key) || !d.NewValueKnown("rule_group.0.rule_variables") {
+		return nil
+	}
+
+	rules := d.Get(key).(string)
+
+	if rules == "" {
+		return nil
+	}
+
+	tfRuleGroup := d.Get("rule_group").([]interface{})
+	ipSets := ruleVariableKeys(ruleGroupRuleVariablesIPSets(tfRuleGroup))
+	portSets := ruleVariableKeys(ruleGroupRuleVariablesPortSets(tfRuleGroup))
+
+	if err := validRulesStringVariableReferences(rules, ipSets, portSets); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+// ruleVariableKeys returns the keys of the IP set or port set variables in tfList.
+func ruleVariableKeys(tfList []interface{}) []string {
+	var keys []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			keys = append(keys, v)
+		}
+	}
+
+	return keys
+}
+
+// resourceRuleGroupCustomizeDiffRulesSourceList rejects domain lists in stateless rule groups.
+// A domain list that only inspects one protocol may be deliberate, so it is reported by a warning on apply instead.
+func resourceRuleGroupCustomizeDiffRulesSourceList(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tfMap := ruleGroupRulesSourceList(d.Get("rule_group").([]interface{}))
+
+	if tfMap == nil || !d.NewValueKnown("rule_group.0.rules_source.0.rules_source_list") {
+		return nil
+	}
+
+	if err := validRulesSourceList(tfMap, d.Get("type").(string)); err != nil {
+		return fmt.Errorf("rule_group.0.rules_source.0.rules_source_list: %w", err)
+	}
+
+	return nil
+}
+
+// ruleGroupRulesSourceList returns the configured domain list, if any, from "rule_group".
+func ruleGroupRulesSourceList(tfRuleGroup []interface{}) map[string]interface{} {
+	if len(tfRuleGroup) == 0 || tfRuleGroup[0] == nil {
+		return nil
+	}
+
+	tfList, ok := tfRuleGroup[0].(map[string]interface{})["rules_source"].([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfList, ok = tfList[0].(map[string]interface{})["rules_source_list"].([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return tfList[0].(map[string]interface{})
+}
+
 func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 
@@ -482,15 +901,19 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Rules = aws.String(v.(string))
 	}
 
+	diags := ruleGroupCapacityDiagnostics(d.Get("capacity").(int), d.Get("rule_group").([]interface{}), aws.StringValue(input.Rules))
+	diags = append(diags, rulesSourceListDiagnostics(ruleGroupRulesSourceList(d.Get("rule_group").([]interface{})))...)
+	diags = append(diags, ruleVariablesIPSetsCapacityDiagnostics(d.Get("capacity").(int), ruleGroupRuleVariablesIPSets(d.Get("rule_group").([]interface{})))...)
+
 	output, err := conn.CreateRuleGroupWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("creating NetworkFirewall Rule Group (%s): %s", name, err)
+		return append(diags, diag.Errorf("creating NetworkFirewall Rule Group (%s): %s", name, err)...)
 	}
 
 	d.SetId(aws.StringValue(output.RuleGroupResponse.RuleGroupArn))
 
-	return resourceRuleGroupRead(ctx, d, meta)
+	return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
 }
 
 func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -513,14 +936,28 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	response := output.RuleGroupResponse
+
+	// The SourceMetadata of a rule group copied from a managed one refers to it, but the copy itself is not managed.
+	if diags := managedRuleGroupDiagnostics(aws.StringValue(response.RuleGroupArn)); diags.HasError() {
+		return diags
+	}
+
 	d.Set("arn", response.RuleGroupArn)
 	d.Set("capacity", response.Capacity)
 	d.Set("description", response.Description)
 	d.Set("encryption_configuration", flattenEncryptionConfiguration(response.EncryptionConfiguration))
 	d.Set("name", response.RuleGroupName)
-	if err := d.Set("rule_group", flattenRuleGroup(output.RuleGroup)); err != nil {
+	tfRuleGroup := flattenRuleGroup(output.RuleGroup)
+	preserveStatefulRuleHeaderDirectionAny(d.Get("rule_group").([]interface{}), tfRuleGroup)
+	if err := d.Set("rule_group", tfRuleGroup); err != nil {
 		return diag.Errorf("setting rule_group: %s", err)
 	}
+	// "rules" is not returned separately in the API response and "rule_group" is Computed from it.
+	// Populate it from the rules string so that rules-based groups can be imported without differences.
+	if output.RuleGroup != nil && output.RuleGroup.RulesSource != nil {
+		d.Set("rules", output.RuleGroup.RulesSource.RulesString)
+	}
+	d.Set("rules_string_output", ruleGroupRulesStringOutput(output.RuleGroup))
 	d.Set("type", response.Type)
 	d.Set("update_token", output.UpdateToken)
 
@@ -530,8 +967,19 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 
+	if d.HasChanges("rule_group", "rules") {
+		var rules string
+		if ruleGroupRulesConfigured(d.GetRawConfig()) {
+			rules = d.Get("rules").(string)
+		}
+		diags = ruleGroupCapacityDiagnostics(d.Get("capacity").(int), d.Get("rule_group").([]interface{}), rules)
+		diags = append(diags, rulesSourceListDiagnostics(ruleGroupRulesSourceList(d.Get("rule_group").([]interface{})))...)
+		diags = append(diags, ruleVariablesIPSetsCapacityDiagnostics(d.Get("capacity").(int), ruleGroupRuleVariablesIPSets(d.Get("rule_group").([]interface{})))...)
+	}
+
 	if d.HasChanges("description", "encryption_configuration", "rule_group", "rules", "type") {
 		input := &networkfirewall.UpdateRuleGroupInput{
 			EncryptionConfiguration: expandEncryptionConfiguration(d.Get("encryption_configuration").([]interface{})),
@@ -546,9 +994,12 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		// Network Firewall UpdateRuleGroup API method only allows one of Rules or RuleGroup
 		// else, request returns "InvalidRequestException: Exactly one of Rules or RuleGroup must be set";
-		// Here, "rules" takes precedence as "rule_group" is Computed from "rules" when configured
+		// Here, "rules" takes precedence as "rule_group" is Computed from "rules" when configured.
+		// "rules" is also Computed from the rule group's rules string, so it is only used if configured.
 		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19414
-		if d.HasChange("rules") {
+		rulesConfigured := ruleGroupRulesConfigured(d.GetRawConfig())
+
+		if rulesConfigured && d.HasChange("rules") {
 			input.Rules = aws.String(d.Get("rules").(string))
 		} else if d.HasChange("rule_group") {
 			if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -560,7 +1011,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		// at least one must still be sent to allow other attributes (ex. description) to update.
 		// Give precedence again to "rules", as documented above.
 		if input.Rules == nil && input.RuleGroup == nil {
-			if v, ok := d.GetOk("rules"); ok {
+			if v, ok := d.GetOk("rules"); ok && rulesConfigured {
 				input.Rules = aws.String(v.(string))
 			} else if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.RuleGroup = expandRuleGroup(v.([]interface{})[0].(map[string]interface{}))
@@ -570,11 +1021,62 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		_, err := conn.UpdateRuleGroupWithContext(ctx, input)
 
 		if err != nil {
-			return diag.Errorf("updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
+			diags = append(diags, diag.Errorf("updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)...)
+
+			// Don't assume that nothing was applied; refresh state from the rule group as it now exists
+			// so that the planned values aren't persisted.
+			return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
+		}
+
+		output, err := FindRuleGroupByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return append(diags, diag.Errorf("reading NetworkFirewall Rule Group (%s): %s", d.Id(), err)...)
+		}
+
+		if discrepancies := ruleGroupUpdateDiscrepancies(d.Id(), input, output); discrepancies.HasError() {
+			diags = append(diags, discrepancies...)
+
+			return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
+		}
+	}
+
+	return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
+}
+
+// ruleGroupRulesConfigured returns whether "rules" is set in the rule group's configuration.
+// "rules" is Optional and Computed, so its value in state doesn't distinguish this from a structured "rule_group"
+// with a "rules_string", which "rules" is read back from.
+func ruleGroupRulesConfigured(rawConfig cty.Value) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+
+	return !rawConfig.GetAttr("rules").IsNull()
+}
+
+// ruleGroupUpdateDiscrepancies returns an error diagnostic for each value sent in an UpdateRuleGroup request
+// that isn't reflected in the rule group's configuration read back after the update.
+func ruleGroupUpdateDiscrepancies(arn string, input *networkfirewall.UpdateRuleGroupInput, output *networkfirewall.DescribeRuleGroupOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if want, got := aws.StringValue(input.Description), aws.StringValue(output.RuleGroupResponse.Description); want != got {
+		diags = append(diags, diag.Errorf("updating NetworkFirewall Rule Group (%s): description is %q after update, expected %q", arn, got, want)...)
+	}
+
+	if input.Rules != nil {
+		var got string
+
+		if output.RuleGroup != nil && output.RuleGroup.RulesSource != nil {
+			got = aws.StringValue(output.RuleGroup.RulesSource.RulesString)
+		}
+
+		if want := aws.StringValue(input.Rules); want != got {
+			diags = append(diags, diag.Errorf("updating NetworkFirewall Rule Group (%s): rules are %q after update, expected %q", arn, got, want)...)
 		}
 	}
 
-	return resourceRuleGroupRead(ctx, d, meta)
+	return diags
 }
 
 func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
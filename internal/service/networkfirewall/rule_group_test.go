@@ -3,6 +3,7 @@ package networkfirewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -59,6 +60,28 @@ func TestAccNetworkFirewallRuleGroup_Basic_rulesSourceList(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_importManaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccRuleGroupConfig_basicSourceList(rName),
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("arn:%s:network-firewall:%s:aws-managed:stateful-rulegroup/MalwareDomainsActionOrder", acctest.Partition(), acctest.Region()),
+				ExpectError:   regexp.MustCompile(`is an AWS managed rule group, which is read-only and can't be managed by this resource`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_Basic_referenceSets(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -96,6 +119,141 @@ func TestAccNetworkFirewallRuleGroup_Basic_referenceSets(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_ReferenceSets_tooMany(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_referenceSetsTooMany(rName),
+				ExpectError: regexp.MustCompile(`6 IP set references supplied \(keys: example1, example2, example3, example4, example5, example6\), but at most 5 are allowed`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_insufficientCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_insufficientCapacity(rName),
+				ExpectError: regexp.MustCompile(`(?i)capacity`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_RulesString_undefinedVariable(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_sourceStringVariables(rName, `pass tcp $HOME_NET any -> $DESTINATION $UNDEFINED_PORTS (sid:1;)`),
+				ExpectError: regexp.MustCompile(`rules reference undefined rule variables \$UNDEFINED_PORTS \(port_sets\)`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_StatefulRule_applicationProtocolRuleOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_statefulRuleApplicationProtocol(rName, "DEFAULT_ACTION_ORDER"),
+				ExpectError: regexp.MustCompile(`stateful rules 0 \(DROP TLS\) use an application-layer protocol`),
+			},
+			{
+				Config: testAccRuleGroupConfig_statefulRuleApplicationProtocol(rName, "STRICT_ORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.header.0.protocol", "TLS"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.stateful_rule_options.0.rule_order", "STRICT_ORDER"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_StatefulRule_directionAny(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_statefulRuleDirection(rName, "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.header.0.direction", "ANY"),
+				),
+			},
+			{
+				// Depending on the engine version, ANY may be read back as FORWARD.
+				Config:   testAccRuleGroupConfig_statefulRuleDirection(rName, "ANY"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Depending on the engine version, an imported ANY direction is FORWARD.
+				ImportStateVerifyIgnore: []string{"rule_group.0.rules_source.0.stateful_rule.0.header.0.direction"},
+			},
+			{
+				Config: testAccRuleGroupConfig_statefulRuleDirection(rName, "FORWARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.header.0.direction", "FORWARD"),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_statefulRuleDirection(rName, "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.0.header.0.direction", "ANY"),
+				),
+			},
+			{
+				Config:   testAccRuleGroupConfig_statefulRuleDirection(rName, "ANY"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_Basic_updateReferenceSets(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -187,6 +345,7 @@ func TestAccNetworkFirewallRuleGroup_Basic_statefulRule(t *testing.T) {
 						"settings.0": "1",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "rule_group.0.stateful_rule_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rules_string_output", "pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -270,14 +429,93 @@ alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:
 					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
 					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rules_string_output", rules),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"rules"}, // argument not returned in RuleGroup API response
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_Rules_import(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	rules := "alert http any any -> any any (http_response_line; content:\"403 Forbidden\"; sid:1;)\npass tls any any -> any any (tls.sni; content:\"example.com\"; endswith; sid:2;)"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_basic(rName, rules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rules", rules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccRuleGroupConfig_basic(rName, rules),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_RulesString_updateDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup1, ruleGroup2 networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	rules := `alert tcp $EXAMPLE any -> any any (msg:"example"; sid:1;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_rulesStringVariables(rName, rules, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup1),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rule_variables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.stateful_rule_options.0.rule_order", networkfirewall.RuleOrderStrictOrder),
+				),
+			},
+			{
+				// Updating only the description must send the structured rule group rather than "rules",
+				// which would drop the rule variables and stateful rule options.
+				Config: testAccRuleGroupConfig_rulesStringVariables(rName, rules, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup2),
+					testAccCheckRuleGroupNotRecreated(&ruleGroup1, &ruleGroup2),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rule_variables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rule_variables.0.ip_sets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.stateful_rule_options.0.rule_order", networkfirewall.RuleOrderStrictOrder),
+				),
+			},
+			{
+				Config:   testAccRuleGroupConfig_rulesStringVariables(rName, rules, "second"),
+				PlanOnly: true,
 			},
 		},
 	})
@@ -410,15 +648,9 @@ func TestAccNetworkFirewallRuleGroup_statelessRuleWithCustomAction(t *testing.T)
 	})
 }
 
-// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19414
-func TestAccNetworkFirewallRuleGroup_updateRules(t *testing.T) {
+func TestAccNetworkFirewallRuleGroup_statelessRuleInvalidAction(t *testing.T) {
 	ctx := acctest.Context(t)
-	var ruleGroup networkfirewall.DescribeRuleGroupOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_networkfirewall_rule_group.test"
-
-	rules := `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"OLD.example.com"; msg:"FQDN test"; sid:1;)`
-	updatedRules := `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"NEW.example.com"; msg:"FQDN test"; sid:1;)`
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
@@ -427,37 +659,20 @@ func TestAccNetworkFirewallRuleGroup_updateRules(t *testing.T) {
 		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRuleGroupConfig_basic(rName, rules),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
-				),
-			},
-			{
-				Config: testAccRuleGroupConfig_basic(rName, updatedRules),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
-					resource.TestCheckResourceAttr(resourceName, "rules", updatedRules),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", updatedRules),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.#", "0"),
-				),
+				Config:      testAccRuleGroupConfig_statelessCustomActionActions(rName, "aws:droop", "example"),
+				ExpectError: regexp.MustCompile(`invalid stateless rule actions "aws:droop" \(priority 1\)`),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"rules"}, // argument not returned in RuleGroup API response
+				Config:      testAccRuleGroupConfig_statelessCustomActionActions(rName, "aws:pass", "exmaple"),
+				ExpectError: regexp.MustCompile(`invalid stateless rule actions "exmaple" \(priority 1\)`),
 			},
 		},
 	})
 }
 
-func TestAccNetworkFirewallRuleGroup_updateRulesSourceList(t *testing.T) {
+func TestAccNetworkFirewallRuleGroup_sourceListInvalidTarget(t *testing.T) {
 	ctx := acctest.Context(t)
-	var ruleGroup networkfirewall.DescribeRuleGroupOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_networkfirewall_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
@@ -466,45 +681,38 @@ func TestAccNetworkFirewallRuleGroup_updateRulesSourceList(t *testing.T) {
 		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRuleGroupConfig_basicSourceList(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
-				),
+				Config:      testAccRuleGroupConfig_sourceListTarget(rName, "http://example.com"),
+				ExpectError: regexp.MustCompile(`must be a domain name, not a URL`),
 			},
 			{
-				Config: testAccRuleGroupConfig_updateSourceList(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
-					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "network-firewall", fmt.Sprintf("stateful-rulegroup/%s", rName)),
-					resource.TestCheckResourceAttr(resourceName, "capacity", "100"),
-					resource.TestCheckResourceAttr(resourceName, "name", rName),
-					resource.TestCheckResourceAttr(resourceName, "type", networkfirewall.RuleGroupTypeStateful),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.generated_rules_type", networkfirewall.GeneratedRulesTypeDenylist),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.target_types.#", "2"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.target_types.*", networkfirewall.TargetTypeHttpHost),
-					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.target_types.*", networkfirewall.TargetTypeTlsSni),
-					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "2"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test.example.com"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test2.example.com"),
-				),
+				Config:      testAccRuleGroupConfig_sourceListTarget(rName, "*.example.com"),
+				ExpectError: regexp.MustCompile(`must not contain wildcards`),
 			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_sourceListStateless(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:      testAccRuleGroupConfig_sourceListStateless(rName),
+				ExpectError: regexp.MustCompile(`domain lists can only be specified for STATEFUL rule groups`),
 			},
 		},
 	})
 }
 
-func TestAccNetworkFirewallRuleGroup_rulesSourceAndRuleVariables(t *testing.T) {
+func TestAccNetworkFirewallRuleGroup_statelessRuleVariableReference(t *testing.T) {
 	ctx := acctest.Context(t)
-	var ruleGroup networkfirewall.DescribeRuleGroupOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_networkfirewall_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
@@ -513,8 +721,163 @@ func TestAccNetworkFirewallRuleGroup_rulesSourceAndRuleVariables(t *testing.T) {
 		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRuleGroupConfig_sourceListVariables(rName),
-				Check: resource.ComposeTestCheckFunc(
+				Config:      testAccRuleGroupConfig_statelessRuleVariableReference(rName, "$HOME_NET"),
+				ExpectError: regexp.MustCompile(`rule variables can only be referenced in stateful rules`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_statelessRuleVariableReference(rName, "10.0.0.0/16"),
+				ExpectError: regexp.MustCompile(`rule variables can only be specified for STATEFUL rule groups`),
+			},
+		},
+	})
+}
+
+// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19414
+func TestAccNetworkFirewallRuleGroup_updateRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	rules := `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"OLD.example.com"; msg:"FQDN test"; sid:1;)`
+	updatedRules := `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"NEW.example.com"; msg:"FQDN test"; sid:1;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_basic(rName, rules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_basic(rName, updatedRules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rules", updatedRules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", updatedRules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_updateRulesInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	rules := `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"OLD.example.com"; msg:"FQDN test"; sid:1;)`
+	invalidRules := `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"NEW.example.com"; msg:"FQDN test"; nosuchkeyword; sid:1;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_basic(rName, rules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+				),
+			},
+			{
+				Config:      testAccRuleGroupConfig_basic(rName, invalidRules),
+				ExpectError: regexp.MustCompile(`updating NetworkFirewall Rule Group`),
+			},
+			{
+				// The failed update must not have recorded the invalid rules in state.
+				Config:   testAccRuleGroupConfig_basic(rName, rules),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccRuleGroupConfig_basic(rName, rules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rules", rules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_updateRulesSourceList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_basicSourceList(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_updateSourceList(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "network-firewall", fmt.Sprintf("stateful-rulegroup/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "100"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", networkfirewall.RuleGroupTypeStateful),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.generated_rules_type", networkfirewall.GeneratedRulesTypeDenylist),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.target_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.target_types.*", networkfirewall.TargetTypeHttpHost),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.target_types.*", networkfirewall.TargetTypeTlsSni),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test.example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test2.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_rulesSourceAndRuleVariables(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_sourceListVariables(rName),
+				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
 					resource.TestCheckResourceAttr(resourceName, "rule_group.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rule_variables.#", "1"),
@@ -1150,6 +1513,49 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_referenceSetsTooMany(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+locals {
+  keys = ["example1", "example2", "example3", "example4", "example5", "example6"]
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    reference_sets {
+      dynamic "ip_set_references" {
+        for_each = local.keys
+
+        content {
+          key = ip_set_references.value
+          ip_set_reference {
+            reference_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:prefix-list/pl-0123456789abcdef${ip_set_references.key}"
+          }
+        }
+      }
+    }
+
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = ["test.example.com"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_referenceSets1(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "example1" {
@@ -1336,6 +1742,74 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_statefulRuleApplicationProtocol(rName, ruleOrder string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      stateful_rule {
+        action = "DROP"
+
+        header {
+          destination      = "ANY"
+          destination_port = "ANY"
+          direction        = "ANY"
+          protocol         = "TLS"
+          source           = "ANY"
+          source_port      = "ANY"
+        }
+
+        rule_option {
+          keyword  = "sid"
+          settings = ["1"]
+        }
+      }
+    }
+
+    stateful_rule_options {
+      rule_order = %[2]q
+    }
+  }
+}
+`, rName, ruleOrder)
+}
+
+func testAccRuleGroupConfig_statefulRuleDirection(rName, direction string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      stateful_rule {
+        action = "PASS"
+
+        header {
+          destination      = "124.1.1.24/32"
+          destination_port = 53
+          direction        = %[2]q
+          protocol         = "TCP"
+          source           = "1.2.3.4/32"
+          source_port      = 53
+        }
+
+        rule_option {
+          keyword  = "sid"
+          settings = ["1"]
+        }
+      }
+    }
+  }
+}
+`, rName, direction)
+}
+
 func testAccRuleGroupConfig_statefulAction(rName, action string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1523,6 +1997,46 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_insufficientCapacity(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 2
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:drop"]
+
+            match_attributes {
+              protocols = [6, 17]
+
+              destination {
+                address_definition = "1.2.3.4/32"
+              }
+
+              destination {
+                address_definition = "1.2.3.5/32"
+              }
+
+              source {
+                address_definition = "124.1.1.5/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_updateStateless(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1601,6 +2115,38 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_sourceStringVariables(rName, rules string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rule_variables {
+      ip_sets {
+        key = "DESTINATION"
+        ip_set {
+          definition = ["10.0.0.0/16"]
+        }
+      }
+
+      port_sets {
+        key = "WEB_PORTS"
+        port_set {
+          definition = ["443", "80"]
+        }
+      }
+    }
+
+    rules_source {
+      rules_string = %[2]q
+    }
+  }
+}
+`, rName, rules)
+}
+
 func testAccRuleGroupConfig_statefulOptions(rName, rules, ruleOrder string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1621,6 +2167,36 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules, ruleOrder)
 }
 
+func testAccRuleGroupConfig_rulesStringVariables(rName, rules, description string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity    = 100
+  description = %[3]q
+  name        = %[1]q
+  type        = "STATEFUL"
+
+  rule_group {
+    rule_variables {
+      ip_sets {
+        key = "EXAMPLE"
+        ip_set {
+          definition = ["10.0.0.0/16"]
+        }
+      }
+    }
+
+    rules_source {
+      rules_string = %[2]q
+    }
+
+    stateful_rule_options {
+      rule_order = "STRICT_ORDER"
+    }
+  }
+}
+`, rName, rules, description)
+}
+
 func testAccRuleGroupConfig_statelessCustomAction(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1667,6 +2243,132 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_statelessCustomActionActions(rName, action1, action2 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        custom_action {
+          action_name = "example"
+
+          action_definition {
+            publish_metric_action {
+              dimension {
+                value = "2"
+              }
+            }
+          }
+        }
+
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = [%[2]q, %[3]q]
+
+            match_attributes {
+              destination {
+                address_definition = "1.2.3.4/32"
+              }
+
+              source {
+                address_definition = "124.1.1.5/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, action1, action2)
+}
+
+func testAccRuleGroupConfig_sourceListTarget(rName, target string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "DENYLIST"
+        target_types         = ["HTTP_HOST", "TLS_SNI"]
+        targets              = [%[2]q]
+      }
+    }
+  }
+}
+`, rName, target)
+}
+
+func testAccRuleGroupConfig_sourceListStateless(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "DENYLIST"
+        target_types         = ["HTTP_HOST", "TLS_SNI"]
+        targets              = ["example.com"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRuleGroupConfig_statelessRuleVariableReference(rName, addressDefinition string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rule_variables {
+      ip_sets {
+        key = "HOME_NET"
+
+        ip_set {
+          definition = ["10.0.0.0/16"]
+        }
+      }
+    }
+
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:pass"]
+
+            match_attributes {
+              source {
+                address_definition = %[2]q
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, addressDefinition)
+}
+
 func testAccRuleGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
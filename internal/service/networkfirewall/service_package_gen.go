@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceFirewallResourcePolicy,
 			TypeName: "aws_networkfirewall_resource_policy",
 		},
+		{
+			Factory:  DataSourceSuricataRules,
+			TypeName: "aws_networkfirewall_suricata_rules",
+		},
 	}
 }
 
@@ -0,0 +1,310 @@
+package networkfirewall
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"golang.org/x/exp/slices"
+)
+
+// ruleGroupRulesStringOutput returns the Suricata compatible representation of a rule group's rules.
+// The API returns the rules string for rule groups defined with one, but not for stateful rules,
+// which are rendered here in the form Network Firewall converts them to.
+// Other rule types have no Suricata compatible representation and return an empty string.
+func ruleGroupRulesStringOutput(ruleGroup *networkfirewall.RuleGroup) string {
+	if ruleGroup == nil || ruleGroup.RulesSource == nil {
+		return ""
+	}
+
+	rulesSource := ruleGroup.RulesSource
+
+	if v := aws.StringValue(rulesSource.RulesString); v != "" {
+		return v
+	}
+
+	var rules []string
+
+	for _, rule := range rulesSource.StatefulRules {
+		if rule == nil || rule.Header == nil {
+			continue
+		}
+
+		rules = append(rules, statefulRuleSuricataString(rule))
+	}
+
+	return strings.Join(rules, "\n")
+}
+
+// statefulRuleSuricataString returns a stateful rule as a Suricata compatible rule, e.g.
+// `pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)`.
+func statefulRuleSuricataString(rule *networkfirewall.StatefulRule) string {
+	header := rule.Header
+
+	direction := "->"
+	if aws.StringValue(header.Direction) == networkfirewall.StatefulRuleDirectionAny {
+		direction = "<>"
+	}
+
+	var options []string
+
+	for _, option := range rule.RuleOptions {
+		if option == nil {
+			continue
+		}
+
+		keyword := aws.StringValue(option.Keyword)
+
+		if len(option.Settings) == 0 {
+			options = append(options, keyword+";")
+			continue
+		}
+
+		options = append(options, fmt.Sprintf("%s:%s;", keyword, strings.Join(aws.StringValueSlice(option.Settings), ",")))
+	}
+
+	return fmt.Sprintf("%s %s %s %s %s %s %s (%s)",
+		strings.ToLower(aws.StringValue(rule.Action)),
+		strings.ToLower(aws.StringValue(header.Protocol)),
+		aws.StringValue(header.Source),
+		aws.StringValue(header.SourcePort),
+		direction,
+		aws.StringValue(header.Destination),
+		aws.StringValue(header.DestinationPort),
+		strings.Join(options, " "),
+	)
+}
+
+// parseSuricataRules parses Suricata compatible rules into stateful rules.
+// Empty lines and comments are skipped and a trailing backslash continues a rule on the next line.
+func parseSuricataRules(rules string) ([]*networkfirewall.StatefulRule, error) {
+	var statefulRules []*networkfirewall.StatefulRule
+	var rule strings.Builder
+	var ruleLine int
+
+	for i, line := range strings.Split(rules, "\n") {
+		line = strings.TrimSpace(line)
+
+		if rule.Len() == 0 {
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			ruleLine = i + 1
+		}
+
+		if strings.HasSuffix(line, "\\") {
+			rule.WriteString(strings.TrimSuffix(line, "\\"))
+			continue
+		}
+
+		rule.WriteString(line)
+
+		statefulRule, err := parseSuricataRule(rule.String())
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ruleLine, err)
+		}
+
+		statefulRules = append(statefulRules, statefulRule)
+		rule.Reset()
+	}
+
+	if rule.Len() > 0 {
+		return nil, fmt.Errorf("line %d: unterminated rule", ruleLine)
+	}
+
+	return statefulRules, nil
+}
+
+// parseSuricataRule parses a single Suricata compatible rule of the form
+// `action protocol source source_port direction destination destination_port (options)`.
+func parseSuricataRule(rule string) (*networkfirewall.StatefulRule, error) {
+	start, end := strings.Index(rule, "("), strings.LastIndex(rule, ")")
+
+	if start == -1 || end < start || strings.TrimSpace(rule[end+1:]) != "" {
+		return nil, fmt.Errorf("rule options must be enclosed in parentheses at the end of the rule: %s", rule)
+	}
+
+	fields := suricataHeaderFields(rule[:start])
+
+	if len(fields) != 7 {
+		return nil, fmt.Errorf("expected 7 header fields (action protocol source source_port direction destination destination_port), got %d: %s", len(fields), rule)
+	}
+
+	action := strings.ToUpper(fields[0])
+	if !slices.Contains(networkfirewall.StatefulAction_Values(), action) {
+		return nil, fmt.Errorf("unsupported action %q", fields[0])
+	}
+
+	protocol := strings.ToUpper(fields[1])
+	if !slices.Contains(networkfirewall.StatefulRuleProtocol_Values(), protocol) {
+		return nil, fmt.Errorf("unsupported protocol %q", fields[1])
+	}
+
+	var direction string
+	switch fields[4] {
+	case "->":
+		direction = networkfirewall.StatefulRuleDirectionForward
+	case "<>":
+		direction = networkfirewall.StatefulRuleDirectionAny
+	default:
+		return nil, fmt.Errorf("unsupported direction %q", fields[4])
+	}
+
+	options, err := parseSuricataRuleOptions(rule[start+1 : end])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkfirewall.StatefulRule{
+		Action: aws.String(action),
+		Header: &networkfirewall.Header{
+			Destination:     aws.String(fields[5]),
+			DestinationPort: aws.String(fields[6]),
+			Direction:       aws.String(direction),
+			Protocol:        aws.String(protocol),
+			Source:          aws.String(fields[2]),
+			SourcePort:      aws.String(fields[3]),
+		},
+		RuleOptions: options,
+	}, nil
+}
+
+// suricataHeaderFields splits a rule header on whitespace, keeping bracketed lists such as
+// `[10.0.0.0/8, !10.0.1.0/24]` as a single field.
+func suricataHeaderFields(header string) []string {
+	var fields []string
+	var field strings.Builder
+	var depth int
+
+	for _, r := range header {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case unicode.IsSpace(r) && depth == 0:
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			continue
+		case unicode.IsSpace(r):
+			continue
+		}
+
+		field.WriteRune(r)
+	}
+
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+
+	return fields
+}
+
+var suricataVariableReferenceRegexp = regexp.MustCompile(`\$([A-Za-z][A-Za-z0-9_]*)`)
+
+// suricataRuleVariableReferences returns the names of the IP set variables referenced in the source and destination
+// and of the port set variables referenced in the source and destination ports of the stateful rules' headers.
+func suricataRuleVariableReferences(rules []*networkfirewall.StatefulRule) ([]string, []string) {
+	ipSets, portSets := map[string]struct{}{}, map[string]struct{}{}
+
+	for _, rule := range rules {
+		if rule == nil || rule.Header == nil {
+			continue
+		}
+
+		for _, v := range []*string{rule.Header.Source, rule.Header.Destination} {
+			for _, match := range suricataVariableReferenceRegexp.FindAllStringSubmatch(aws.StringValue(v), -1) {
+				ipSets[match[1]] = struct{}{}
+			}
+		}
+
+		for _, v := range []*string{rule.Header.SourcePort, rule.Header.DestinationPort} {
+			for _, match := range suricataVariableReferenceRegexp.FindAllStringSubmatch(aws.StringValue(v), -1) {
+				portSets[match[1]] = struct{}{}
+			}
+		}
+	}
+
+	return sortedKeys(ipSets), sortedKeys(portSets)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// parseSuricataRuleOptions parses `keyword;` and `keyword:settings;` options.
+// Semicolons inside quoted settings or escaped with a backslash don't terminate an option.
+func parseSuricataRuleOptions(s string) ([]*networkfirewall.RuleOption, error) {
+	var options []*networkfirewall.RuleOption
+	var option strings.Builder
+	var quoted, escaped bool
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case r == ';' && !quoted:
+			o, err := parseSuricataRuleOption(option.String())
+
+			if err != nil {
+				return nil, err
+			}
+
+			options = append(options, o)
+			option.Reset()
+			continue
+		}
+
+		option.WriteRune(r)
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string in rule options: %s", s)
+	}
+
+	if v := strings.TrimSpace(option.String()); v != "" {
+		return nil, fmt.Errorf("rule option %q must be terminated with a semicolon", v)
+	}
+
+	return options, nil
+}
+
+func parseSuricataRuleOption(s string) (*networkfirewall.RuleOption, error) {
+	keyword, settings, found := strings.Cut(s, ":")
+	keyword = strings.TrimSpace(keyword)
+
+	if keyword == "" {
+		return nil, fmt.Errorf("empty rule option keyword: %q", s)
+	}
+
+	option := &networkfirewall.RuleOption{
+		Keyword: aws.String(keyword),
+	}
+
+	if found {
+		option.Settings = aws.StringSlice([]string{strings.TrimSpace(settings)})
+	}
+
+	return option, nil
+}
@@ -0,0 +1,101 @@
+package networkfirewall
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+// @SDKDataSource("aws_networkfirewall_suricata_rules")
+func DataSourceSuricataRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSuricataRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"rules": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stateful_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"header": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"destination_port": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_port": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"rule_option": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keyword": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"settings": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSuricataRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rules := d.Get("rules").(string)
+	statefulRules, err := parseSuricataRules(rules)
+
+	if err != nil {
+		return diag.Errorf("parsing NetworkFirewall Suricata rules: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(rules)))
+	if err := d.Set("stateful_rule", flattenStatefulRules(statefulRules)); err != nil {
+		return diag.Errorf("setting stateful_rule: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package networkfirewall_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkFirewallSuricataRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkfirewall_suricata_rules.test"
+	resourceName := "aws_networkfirewall_rule_group.test"
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuricataRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.action", networkfirewall.StatefulActionPass),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.header.0.destination", "124.1.1.24/32"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.header.0.destination_port", "53"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.header.0.direction", networkfirewall.StatefulRuleDirectionAny),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.header.0.protocol", networkfirewall.StatefulRuleProtocolTcp),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.header.0.source", "1.2.3.4/32"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.header.0.source_port", "53"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.rule_option.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.rule_option.0.keyword", "sid"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.0.rule_option.0.settings.0", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.1.action", networkfirewall.StatefulActionDrop),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.1.header.0.direction", networkfirewall.StatefulRuleDirectionForward),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule.1.rule_option.#", "2"),
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.stateful_rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallSuricataRulesDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSuricataRulesDataSourceConfig_invalid,
+				ExpectError: regexp.MustCompile(`line 2: unsupported direction "<-"`),
+			},
+		},
+	})
+}
+
+func testAccSuricataRulesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_networkfirewall_suricata_rules" "test" {
+  rules = <<EOT
+# Allow DNS
+pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)
+drop ip $HOME_NET ANY -> ANY ANY (nocase; sid:2;)
+EOT
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      dynamic "stateful_rule" {
+        for_each = data.aws_networkfirewall_suricata_rules.test.stateful_rule
+
+        content {
+          action = stateful_rule.value.action
+
+          header {
+            destination      = stateful_rule.value.header[0].destination
+            destination_port = stateful_rule.value.header[0].destination_port
+            direction        = stateful_rule.value.header[0].direction
+            protocol         = stateful_rule.value.header[0].protocol
+            source           = stateful_rule.value.header[0].source
+            source_port      = stateful_rule.value.header[0].source_port
+          }
+
+          dynamic "rule_option" {
+            for_each = stateful_rule.value.rule_option
+
+            content {
+              keyword  = rule_option.value.keyword
+              settings = rule_option.value.settings
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+const testAccSuricataRulesDataSourceConfig_invalid = `
+data "aws_networkfirewall_suricata_rules" "test" {
+  rules = <<EOT
+pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)
+pass tcp any any <- any any (sid:2;)
+EOT
+}
+`
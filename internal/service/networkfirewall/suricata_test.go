@@ -0,0 +1,306 @@
+package networkfirewall
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+)
+
+func TestRuleGroupRulesStringOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		ruleGroup *networkfirewall.RuleGroup
+		expected  string
+	}{
+		{
+			name:     "nil",
+			expected: "",
+		},
+		{
+			name: "rules string",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					RulesString: aws.String(`alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)`),
+				},
+			},
+			expected: `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)`,
+		},
+		{
+			name: "stateful rules",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					StatefulRules: []*networkfirewall.StatefulRule{
+						{
+							Action: aws.String(networkfirewall.StatefulActionPass),
+							Header: &networkfirewall.Header{
+								Destination:     aws.String("124.1.1.24/32"),
+								DestinationPort: aws.String("53"),
+								Direction:       aws.String(networkfirewall.StatefulRuleDirectionAny),
+								Protocol:        aws.String(networkfirewall.StatefulRuleProtocolTcp),
+								Source:          aws.String("1.2.3.4/32"),
+								SourcePort:      aws.String("53"),
+							},
+							RuleOptions: []*networkfirewall.RuleOption{
+								{
+									Keyword:  aws.String("sid"),
+									Settings: aws.StringSlice([]string{"1"}),
+								},
+							},
+						},
+						{
+							Action: aws.String(networkfirewall.StatefulActionDrop),
+							Header: &networkfirewall.Header{
+								Destination:     aws.String("ANY"),
+								DestinationPort: aws.String("ANY"),
+								Direction:       aws.String(networkfirewall.StatefulRuleDirectionForward),
+								Protocol:        aws.String(networkfirewall.StatefulRuleProtocolIp),
+								Source:          aws.String("$HOME_NET"),
+								SourcePort:      aws.String("ANY"),
+							},
+							RuleOptions: []*networkfirewall.RuleOption{
+								{
+									Keyword: aws.String("nocase"),
+								},
+								{
+									Keyword:  aws.String("sid"),
+									Settings: aws.StringSlice([]string{"2"}),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: "pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)\ndrop ip $HOME_NET ANY -> ANY ANY (nocase; sid:2;)",
+		},
+		{
+			name: "stateless rules",
+			ruleGroup: &networkfirewall.RuleGroup{
+				RulesSource: &networkfirewall.RulesSource{
+					StatelessRulesAndCustomActions: &networkfirewall.StatelessRulesAndCustomActions{
+						StatelessRules: []*networkfirewall.StatelessRule{
+							{
+								Priority: aws.Int64(1),
+							},
+						},
+					},
+				},
+			},
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ruleGroupRulesStringOutput(testCase.ruleGroup); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParseSuricataRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		rules         string
+		expected      []*networkfirewall.StatefulRule
+		expectedError bool
+	}{
+		{
+			name:  "empty",
+			rules: "",
+		},
+		{
+			name:  "comments and blank lines",
+			rules: "# drop everything\n\n   # really\n",
+		},
+		{
+			name:  "pass bidirectional",
+			rules: "pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)",
+			expected: []*networkfirewall.StatefulRule{
+				{
+					Action: aws.String(networkfirewall.StatefulActionPass),
+					Header: &networkfirewall.Header{
+						Destination:     aws.String("124.1.1.24/32"),
+						DestinationPort: aws.String("53"),
+						Direction:       aws.String(networkfirewall.StatefulRuleDirectionAny),
+						Protocol:        aws.String(networkfirewall.StatefulRuleProtocolTcp),
+						Source:          aws.String("1.2.3.4/32"),
+						SourcePort:      aws.String("53"),
+					},
+					RuleOptions: []*networkfirewall.RuleOption{
+						{
+							Keyword:  aws.String("sid"),
+							Settings: aws.StringSlice([]string{"1"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple rules",
+			rules: `# Block TLS to a domain
+drop tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"evil.example.com"; startswith; nocase; endswith; msg:"matching TLS denylisted FQDNs"; priority:1; flow:to_server, established; sid:1; rev:1;)
+alert http any any -> any any (http_response_line; content:"403 Forbidden; denied"; sid:2;)`,
+			expected: []*networkfirewall.StatefulRule{
+				{
+					Action: aws.String(networkfirewall.StatefulActionDrop),
+					Header: &networkfirewall.Header{
+						Destination:     aws.String("$EXTERNAL_NET"),
+						DestinationPort: aws.String("443"),
+						Direction:       aws.String(networkfirewall.StatefulRuleDirectionForward),
+						Protocol:        aws.String(networkfirewall.StatefulRuleProtocolTls),
+						Source:          aws.String("$HOME_NET"),
+						SourcePort:      aws.String("any"),
+					},
+					RuleOptions: []*networkfirewall.RuleOption{
+						{Keyword: aws.String("tls.sni")},
+						{Keyword: aws.String("content"), Settings: aws.StringSlice([]string{`"evil.example.com"`})},
+						{Keyword: aws.String("startswith")},
+						{Keyword: aws.String("nocase")},
+						{Keyword: aws.String("endswith")},
+						{Keyword: aws.String("msg"), Settings: aws.StringSlice([]string{`"matching TLS denylisted FQDNs"`})},
+						{Keyword: aws.String("priority"), Settings: aws.StringSlice([]string{"1"})},
+						{Keyword: aws.String("flow"), Settings: aws.StringSlice([]string{"to_server, established"})},
+						{Keyword: aws.String("sid"), Settings: aws.StringSlice([]string{"1"})},
+						{Keyword: aws.String("rev"), Settings: aws.StringSlice([]string{"1"})},
+					},
+				},
+				{
+					Action: aws.String(networkfirewall.StatefulActionAlert),
+					Header: &networkfirewall.Header{
+						Destination:     aws.String("any"),
+						DestinationPort: aws.String("any"),
+						Direction:       aws.String(networkfirewall.StatefulRuleDirectionForward),
+						Protocol:        aws.String(networkfirewall.StatefulRuleProtocolHttp),
+						Source:          aws.String("any"),
+						SourcePort:      aws.String("any"),
+					},
+					RuleOptions: []*networkfirewall.RuleOption{
+						{Keyword: aws.String("http_response_line")},
+						{Keyword: aws.String("content"), Settings: aws.StringSlice([]string{`"403 Forbidden; denied"`})},
+						{Keyword: aws.String("sid"), Settings: aws.StringSlice([]string{"2"})},
+					},
+				},
+			},
+		},
+		{
+			name:  "address list and line continuation",
+			rules: "reject udp [10.0.0.0/8, !10.0.1.0/24] any -> any [53,5353] \\\n  (msg:\"escaped \\; semicolon\"; sid:3;)",
+			expected: []*networkfirewall.StatefulRule{
+				{
+					Action: aws.String(networkfirewall.StatefulActionReject),
+					Header: &networkfirewall.Header{
+						Destination:     aws.String("any"),
+						DestinationPort: aws.String("[53,5353]"),
+						Direction:       aws.String(networkfirewall.StatefulRuleDirectionForward),
+						Protocol:        aws.String(networkfirewall.StatefulRuleProtocolUdp),
+						Source:          aws.String("[10.0.0.0/8,!10.0.1.0/24]"),
+						SourcePort:      aws.String("any"),
+					},
+					RuleOptions: []*networkfirewall.RuleOption{
+						{Keyword: aws.String("msg"), Settings: aws.StringSlice([]string{`"escaped \; semicolon"`})},
+						{Keyword: aws.String("sid"), Settings: aws.StringSlice([]string{"3"})},
+					},
+				},
+			},
+		},
+		{
+			name:          "missing options",
+			rules:         "pass tcp any any -> any any",
+			expectedError: true,
+		},
+		{
+			name:          "missing header field",
+			rules:         "pass tcp any -> any any (sid:1;)",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported action",
+			rules:         "log tcp any any -> any any (sid:1;)",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported protocol",
+			rules:         "pass sctp any any -> any any (sid:1;)",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported direction",
+			rules:         "pass tcp any any <- any any (sid:1;)",
+			expectedError: true,
+		},
+		{
+			name:          "unterminated option",
+			rules:         "pass tcp any any -> any any (sid:1)",
+			expectedError: true,
+		},
+		{
+			name:          "unterminated quote",
+			rules:         `alert http any any -> any any (content:"403; sid:1;)`,
+			expectedError: true,
+		},
+		{
+			name:          "unterminated continuation",
+			rules:         "pass tcp any any -> any any \\",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseSuricataRules(testCase.rules)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParseSuricataRulesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rules := "pass tcp 1.2.3.4/32 53 <> 124.1.1.24/32 53 (sid:1;)\ndrop ip $HOME_NET ANY -> ANY ANY (nocase; sid:2;)"
+
+	statefulRules, err := parseSuricataRules(rules)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := ruleGroupRulesStringOutput(&networkfirewall.RuleGroup{
+		RulesSource: &networkfirewall.RulesSource{
+			StatefulRules: statefulRules,
+		},
+	})
+
+	if got != rules {
+		t.Errorf("got %q, expected %q", got, rules)
+	}
+}
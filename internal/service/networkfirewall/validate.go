@@ -0,0 +1,610 @@
+package networkfirewall
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
+)
+
+var rulesSourceListTargetLabelRegexp = regexp.MustCompile(`^[0-9A-Za-z]([0-9A-Za-z-]{0,61}[0-9A-Za-z])?$`)
+
+// validRulesSourceListTarget validates a domain list target: a domain name ("example.com"), optionally with a
+// leading dot to also match its subdomains (".example.com"). Targets are matched against the TLS SNI or HTTP Host
+// header, so a URL or any other wildcard results in a rule that never matches.
+func validRulesSourceListTarget(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	switch {
+	case strings.Contains(value, "://"):
+		errors = append(errors, fmt.Errorf("%q (%s) must be a domain name, not a URL", k, value))
+		return
+	case strings.Contains(value, "/"):
+		errors = append(errors, fmt.Errorf("%q (%s) must be a domain name without a path", k, value))
+		return
+	case strings.Contains(value, "*"):
+		errors = append(errors, fmt.Errorf("%q (%s) must not contain wildcards; use a leading dot (e.g., \".example.com\") to match subdomains", k, value))
+		return
+	}
+
+	for _, label := range strings.Split(strings.TrimPrefix(value, "."), ".") {
+		if !rulesSourceListTargetLabelRegexp.MatchString(label) {
+			errors = append(errors, fmt.Errorf("%q (%s) must be a domain name (e.g., \"example.com\") or a domain name with a leading dot (e.g., \".example.com\")", k, value))
+			return
+		}
+	}
+
+	return
+}
+
+// validStatelessRuleAddressDefinition validates a stateless rule source or destination address definition.
+// Rule variables are only available to stateful rules, so a "$" variable reference gets a specific error
+// rather than the generic CIDR one.
+func validStatelessRuleAddressDefinition(v interface{}, k string) (ws []string, errors []error) {
+	if value, ok := v.(string); ok && strings.HasPrefix(value, "$") {
+		errors = append(errors, fmt.Errorf("%q (%s): rule variables can only be referenced in stateful rules; stateless rules require a CIDR block", k, value))
+		return
+	}
+
+	return verify.ValidIPv4CIDRNetworkAddress(v, k)
+}
+
+// validStatelessRulePriorities validates that each stateless rule, in the form accepted by expandStatelessRules,
+// has a priority of at least 1 and that no two rules share a priority.
+func validStatelessRulePriorities(tfList []interface{}) error {
+	var missing int
+	counts := make(map[int]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		priority, _ := tfMap["priority"].(int)
+
+		if priority < 1 {
+			missing++
+			continue
+		}
+
+		counts[priority]++
+	}
+
+	var duplicates []int
+
+	for priority, count := range counts {
+		if count > 1 {
+			duplicates = append(duplicates, priority)
+		}
+	}
+
+	sort.Ints(duplicates)
+
+	var errs []string
+
+	if missing > 0 {
+		errs = append(errs, fmt.Sprintf("%d stateless rule(s) without a priority of at least 1", missing))
+	}
+
+	if len(duplicates) > 0 {
+		var priorities []string
+
+		for _, priority := range duplicates {
+			priorities = append(priorities, strconv.Itoa(priority))
+		}
+
+		errs = append(errs, fmt.Sprintf("duplicate stateless rule priorities: %s", strings.Join(priorities, ", ")))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid stateless rule priorities: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+var statelessRuleStandardActions = []string{
+	"aws:drop",
+	"aws:forward_to_sfe",
+	"aws:pass",
+}
+
+// validStatelessRuleActions validates that each action of the stateless rules, in the form accepted by expandStatelessRules,
+// is either a standard action or the name of one of the custom actions, in the form accepted by expandCustomActions.
+func validStatelessRuleActions(tfList []interface{}, customActions []interface{}) error {
+	valid := make(map[string]bool)
+
+	for _, v := range statelessRuleStandardActions {
+		valid[v] = true
+	}
+
+	for _, tfMapRaw := range customActions {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["action_name"].(string); ok && v != "" {
+			valid[v] = true
+		}
+	}
+
+	var invalid []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		priority, _ := tfMap["priority"].(int)
+
+		v, ok := tfMap["rule_definition"].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		actions, ok := v[0].(map[string]interface{})["actions"].(*schema.Set)
+
+		if !ok {
+			continue
+		}
+
+		for _, action := range flex.ExpandStringValueSet(actions) {
+			if !valid[action] {
+				invalid = append(invalid, fmt.Sprintf("%q (priority %d)", action, priority))
+			}
+		}
+	}
+
+	sort.Strings(invalid)
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid stateless rule actions %s: actions must be a standard action (%s) or the action_name of a custom_action",
+			strings.Join(invalid, ", "), strings.Join(statelessRuleStandardActions, ", "))
+	}
+
+	return nil
+}
+
+// validRulesSourceList validates a domain list rule source, in the form accepted by expandRulesSourceList.
+// Domain lists generate stateful rules, so they can't be used in stateless rule groups.
+func validRulesSourceList(tfMap map[string]interface{}, ruleGroupType string) error {
+	if tfMap == nil || ruleGroupType != networkfirewall.RuleGroupTypeStateless {
+		return nil
+	}
+
+	return fmt.Errorf("invalid rules source list: domain lists can only be specified for %s rule groups", networkfirewall.RuleGroupTypeStateful)
+}
+
+// rulesSourceListDiagnostics returns a warning if a domain list, in the form accepted by expandRulesSourceList,
+// only inspects one of HTTP_HOST and TLS_SNI. The generated rules only match the protocols of the configured target types,
+// so a deny list leaves the domains reachable over the other protocol and an allow list doesn't restrict it at all.
+// It is a warning rather than an error as the rule group may deliberately inspect a single protocol.
+func rulesSourceListDiagnostics(tfMap map[string]interface{}) diag.Diagnostics {
+	if tfMap == nil {
+		return nil
+	}
+
+	v, ok := tfMap["target_types"].(*schema.Set)
+
+	if !ok || v.Len() != 1 {
+		return nil
+	}
+
+	targetType := v.List()[0].(string)
+	var protocol, otherProtocol string
+
+	switch targetType {
+	case networkfirewall.TargetTypeHttpHost:
+		protocol, otherProtocol = "HTTP", "HTTPS"
+	case networkfirewall.TargetTypeTlsSni:
+		protocol, otherProtocol = "HTTPS", "HTTP"
+	default:
+		return nil
+	}
+
+	var detail string
+
+	switch generatedRulesType, _ := tfMap["generated_rules_type"].(string); generatedRulesType {
+	case networkfirewall.GeneratedRulesTypeAllowlist:
+		detail = fmt.Sprintf("target_types is [%q], so only %s traffic is restricted to the targets; %s traffic to any domain is not matched by this allow list.", targetType, protocol, otherProtocol)
+	case networkfirewall.GeneratedRulesTypeDenylist:
+		detail = fmt.Sprintf("target_types is [%q], so only %s traffic to the targets is denied; %s traffic to the same domains is not matched by this deny list.", targetType, protocol, otherProtocol)
+	default:
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "NetworkFirewall Rule Group domain list only inspects " + protocol + " traffic",
+			Detail:   detail + fmt.Sprintf(" Include both %q and %q in target_types to inspect HTTP and HTTPS traffic.", networkfirewall.TargetTypeHttpHost, networkfirewall.TargetTypeTlsSni),
+		},
+	}
+}
+
+// ruleVariablesIPSetDefinitionMaxItems is the maximum number of CIDR blocks in the definition of an IP set variable.
+const ruleVariablesIPSetDefinitionMaxItems = 10000
+
+// validRuleVariablesIPSets validates the number of CIDR blocks in the definition of each IP set variable,
+// in the form accepted by expandIPSets. Without it, an oversized IP set is only rejected by the API,
+// after the whole rule group has been uploaded.
+func validRuleVariablesIPSets(tfList []interface{}) error {
+	var errs []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key, _ := tfMap["key"].(string)
+
+		if n := ipSetDefinitionLen(tfMap); n > ruleVariablesIPSetDefinitionMaxItems {
+			errs = append(errs, fmt.Sprintf("%s (%d)", key, n))
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+
+		return fmt.Errorf("IP sets %s define more than the maximum of %d CIDR blocks; split them into several IP sets", strings.Join(errs, ", "), ruleVariablesIPSetDefinitionMaxItems)
+	}
+
+	return nil
+}
+
+// ipSetDefinitionLen returns the number of CIDR blocks in the definition of an IP set variable.
+func ipSetDefinitionLen(tfMap map[string]interface{}) int {
+	tfList, ok := tfMap["ip_set"].([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return 0
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["definition"].(*schema.Set); ok {
+		return v.Len()
+	}
+
+	return 0
+}
+
+// ruleVariablesImplicitIPSets are the IP set variables that Network Firewall defines when a stateful rule group doesn't:
+// HOME_NET defaults to the CIDR ranges of the firewall's VPC and EXTERNAL_NET to their negation.
+var ruleVariablesImplicitIPSets = []string{"EXTERNAL_NET", "HOME_NET"}
+
+// validRulesStringVariableReferences validates that the variables referenced in the headers of the Suricata compatible rules
+// are defined among the keys of the rule group's IP set and port set variables. Rule options are not checked, as their
+// settings can contain a literal "$", and rules that can't be parsed are left for the API to reject.
+func validRulesStringVariableReferences(rules string, ipSets, portSets []string) error {
+	statefulRules, err := parseSuricataRules(rules)
+
+	if err != nil {
+		return nil
+	}
+
+	ipSetReferences, portSetReferences := suricataRuleVariableReferences(statefulRules)
+	var errs []string
+
+	for _, v := range ipSetReferences {
+		if !slices.Contains(ipSets, v) && !slices.Contains(ruleVariablesImplicitIPSets, v) {
+			errs = append(errs, fmt.Sprintf("$%s (ip_sets)", v))
+		}
+	}
+
+	for _, v := range portSetReferences {
+		if !slices.Contains(portSets, v) {
+			errs = append(errs, fmt.Sprintf("$%s (port_sets)", v))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("rules reference undefined rule variables %s; define them in rule_group.0.rule_variables", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// validStatefulRuleProtocols validates protocol-specific constraints on stateful rules, in the form accepted by expandStatefulRules.
+// Rules that drop, reject or alert on an application-layer protocol (any protocol other than IP, TCP, UDP or ICMP)
+// only match once the protocol has been identified, so under the default action order the pass rules
+// for the underlying transport are evaluated first; these rules require a rule_order of STRICT_ORDER.
+func validStatefulRuleProtocols(tfList []interface{}, ruleOrder string) error {
+	if ruleOrder == networkfirewall.RuleOrderStrictOrder {
+		return nil
+	}
+
+	var rules []string
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		action, _ := tfMap["action"].(string)
+
+		if action == "" || action == networkfirewall.StatefulActionPass {
+			continue
+		}
+
+		var protocol string
+
+		if v, ok := tfMap["header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			protocol, _ = v[0].(map[string]interface{})["protocol"].(string)
+		}
+
+		switch protocol {
+		case "", networkfirewall.StatefulRuleProtocolIp, networkfirewall.StatefulRuleProtocolTcp, networkfirewall.StatefulRuleProtocolUdp, networkfirewall.StatefulRuleProtocolIcmp:
+			continue
+		}
+
+		rules = append(rules, fmt.Sprintf("%d (%s %s)", i, action, protocol))
+	}
+
+	if len(rules) > 0 {
+		return fmt.Errorf("stateful rules %s use an application-layer protocol with a %s, %s or %s action, which requires a stateful_rule_options rule_order of %s",
+			strings.Join(rules, ", "), networkfirewall.StatefulActionDrop, networkfirewall.StatefulActionReject, networkfirewall.StatefulActionAlert, networkfirewall.RuleOrderStrictOrder)
+	}
+
+	return nil
+}
+
+const statefulRuleOptionKeywordFlowbits = "flowbits"
+
+var flowbitsNameRegexp = regexp.MustCompile(`^[^\s,;|&"]+$`)
+
+// validFlowbitsSetting validates the setting of a Suricata flowbits rule option: "noalert", or a command
+// and a flowbit name separated by a comma ("set,bitname"). The isset and isnotset commands may check
+// any of several flowbits, separated by pipes ("isset,bit1|bit2").
+func validFlowbitsSetting(setting string) error {
+	parts := strings.Split(setting, ",")
+
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	command := parts[0]
+
+	switch command {
+	case "noalert":
+		if len(parts) != 1 {
+			return fmt.Errorf("flowbits setting %q: %s does not take a flowbit name", setting, command)
+		}
+
+		return nil
+	case "set", "unset", "toggle", "isset", "isnotset":
+	default:
+		return fmt.Errorf("flowbits setting %q: command must be one of set, unset, toggle, isset, isnotset or noalert", setting)
+	}
+
+	if len(parts) != 2 || parts[1] == "" {
+		return fmt.Errorf("flowbits setting %q: expected %s,<name>", setting, command)
+	}
+
+	names := []string{parts[1]}
+
+	if command == "isset" || command == "isnotset" {
+		names = strings.Split(parts[1], "|")
+	}
+
+	for _, name := range names {
+		if !flowbitsNameRegexp.MatchString(strings.TrimSpace(name)) {
+			return fmt.Errorf("flowbits setting %q: invalid flowbit name %q", setting, strings.TrimSpace(name))
+		}
+	}
+
+	return nil
+}
+
+// validStatefulRuleOptions validates the settings of the rule options of stateful rules, in the form accepted by
+// expandStatefulRules, for the keywords whose settings have a grammar that is only checked by the API on apply.
+// Each flowbits rule option takes exactly one setting.
+func validStatefulRuleOptions(tfList []interface{}) error {
+	var errs []string
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, ruleOptionRaw := range interfaceList(tfMap["rule_option"]) {
+			ruleOption, ok := ruleOptionRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if keyword, _ := ruleOption["keyword"].(string); !strings.EqualFold(strings.TrimSpace(keyword), statefulRuleOptionKeywordFlowbits) {
+				continue
+			}
+
+			settings := flex.ExpandStringValueList(interfaceList(ruleOption["settings"]))
+
+			if len(settings) != 1 {
+				errs = append(errs, fmt.Sprintf("stateful rule %d: flowbits rule options take exactly one setting, got %d", i, len(settings)))
+				continue
+			}
+
+			if err := validFlowbitsSetting(settings[0]); err != nil {
+				errs = append(errs, fmt.Sprintf("stateful rule %d: %s", i, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid flowbits rule options: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// interfaceList returns the elements of a list or set attribute value.
+func interfaceList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+
+	return nil
+}
+
+// validStatefulRuleGroupReferences validates the stateful rule group references of a firewall policy,
+// in the form accepted by expandStatefulRuleGroupReferences, against the policy's stateful engine rule order.
+// Each rule group may be referenced at most once. Under STRICT_ORDER every reference requires a priority
+// and no two references may share one; under the default action order priorities are not allowed.
+// References to AWS managed rule groups must name a stateful rule group returned by findManaged, which
+// looks them up by name in the MANAGED scope. References whose ARN is not yet known are ignored.
+func validStatefulRuleGroupReferences(tfList []interface{}, ruleOrder string, findManaged func(name string) (*networkfirewall.RuleGroupMetadata, error)) error {
+	strictOrder := ruleOrder == networkfirewall.RuleOrderStrictOrder
+	arnCounts := make(map[string]int)
+	priorityARNs := make(map[int][]string)
+	var missing, unexpected, unknownManaged []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		resourceARN, _ := tfMap["resource_arn"].(string)
+
+		if !arn.IsARN(resourceARN) {
+			continue
+		}
+
+		arnCounts[resourceARN]++
+
+		if isManagedRuleGroupARN(resourceARN) && arnCounts[resourceARN] == 1 {
+			found, err := managedStatefulRuleGroupExists(resourceARN, findManaged)
+
+			if err != nil {
+				return fmt.Errorf("reading AWS managed rule group (%s): %w", resourceARN, err)
+			}
+
+			if !found {
+				unknownManaged = append(unknownManaged, resourceARN)
+			}
+		}
+
+		priority, _ := tfMap["priority"].(int)
+
+		switch {
+		case priority > 0 && !strictOrder:
+			unexpected = append(unexpected, resourceARN)
+		case priority > 0:
+			priorityARNs[priority] = append(priorityARNs[priority], resourceARN)
+		case strictOrder:
+			missing = append(missing, resourceARN)
+		}
+	}
+
+	var duplicateARNs []string
+
+	for resourceARN, count := range arnCounts {
+		if count > 1 {
+			duplicateARNs = append(duplicateARNs, resourceARN)
+		}
+	}
+
+	var duplicatePriorities []int
+
+	for priority, arns := range priorityARNs {
+		if len(arns) > 1 {
+			duplicatePriorities = append(duplicatePriorities, priority)
+		}
+	}
+
+	sort.Strings(duplicateARNs)
+	sort.Ints(duplicatePriorities)
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	sort.Strings(unknownManaged)
+
+	var errs []string
+
+	if len(duplicateARNs) > 0 {
+		errs = append(errs, fmt.Sprintf("rule groups referenced more than once: %s", strings.Join(duplicateARNs, ", ")))
+	}
+
+	for _, priority := range duplicatePriorities {
+		arns := priorityARNs[priority]
+		sort.Strings(arns)
+		errs = append(errs, fmt.Sprintf("duplicate priority %d: %s", priority, strings.Join(arns, ", ")))
+	}
+
+	if len(missing) > 0 {
+		errs = append(errs, fmt.Sprintf("priority is required with a rule_order of %s: %s", networkfirewall.RuleOrderStrictOrder, strings.Join(missing, ", ")))
+	}
+
+	if len(unexpected) > 0 {
+		errs = append(errs, fmt.Sprintf("priority is only allowed with a rule_order of %s: %s", networkfirewall.RuleOrderStrictOrder, strings.Join(unexpected, ", ")))
+	}
+
+	if len(unknownManaged) > 0 {
+		errs = append(errs, fmt.Sprintf("not stateful AWS managed rule groups: %s", strings.Join(unknownManaged, ", ")))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid stateful rule group references: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// managedStatefulRuleGroupExists returns whether the AWS managed rule group with the specified ARN
+// is a stateful rule group returned by findManaged.
+func managedStatefulRuleGroupExists(resourceARN string, findManaged func(name string) (*networkfirewall.RuleGroupMetadata, error)) (bool, error) {
+	v, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return false, err
+	}
+
+	name := strings.TrimPrefix(v.Resource, "stateful-rulegroup/")
+
+	if name == v.Resource {
+		return false, nil
+	}
+
+	ruleGroup, err := findManaged(name)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return aws.StringValue(ruleGroup.Arn) == resourceARN, nil
+}
@@ -0,0 +1,1112 @@
+package networkfirewall
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestValidRulesSourceListTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "domain",
+			input: "example.com",
+		},
+		{
+			name:  "subdomain",
+			input: "test.example.com",
+		},
+		{
+			name:  "leading dot",
+			input: ".example.com",
+		},
+		{
+			name:  "hyphen and digits",
+			input: "my-site1.example.co.uk",
+		},
+		{
+			name:  "single label",
+			input: "localhost",
+		},
+		{
+			name:          "URL",
+			input:         "http://example.com",
+			expectedError: regexp.MustCompile(`must be a domain name, not a URL`),
+		},
+		{
+			name:          "path",
+			input:         "example.com/index.html",
+			expectedError: regexp.MustCompile(`must be a domain name without a path`),
+		},
+		{
+			name:          "wildcard",
+			input:         "*.example.com",
+			expectedError: regexp.MustCompile(`must not contain wildcards`),
+		},
+		{
+			name:          "empty",
+			input:         "",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+		{
+			name:          "only dot",
+			input:         ".",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+		{
+			name:          "two leading dots",
+			input:         "..example.com",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+		{
+			name:          "trailing dot",
+			input:         "example.com.",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+		{
+			name:          "port",
+			input:         "example.com:443",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+		{
+			name:          "label with leading hyphen",
+			input:         "-example.com",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+		{
+			name:          "whitespace",
+			input:         " example.com",
+			expectedError: regexp.MustCompile(`must be a domain name`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validRulesSourceListTarget(testCase.input, "targets")
+
+			if testCase.expectedError == nil {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+
+				return
+			}
+
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %v", errs)
+			}
+
+			if !testCase.expectedError.MatchString(errs[0].Error()) {
+				t.Fatalf("unexpected error: %s", errs[0])
+			}
+		})
+	}
+}
+
+func TestValidStatelessRuleAddressDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "CIDR block",
+			input: "10.0.0.0/16",
+		},
+		{
+			name:  "single address",
+			input: "1.2.3.4/32",
+		},
+		{
+			name:          "rule variable reference",
+			input:         "$HOME_NET",
+			expectedError: regexp.MustCompile(`rule variables can only be referenced in stateful rules`),
+		},
+		{
+			name:          "host bits set",
+			input:         "10.0.0.1/16",
+			expectedError: regexp.MustCompile(`10\.0\.0\.1/16`),
+		},
+		{
+			name:          "not a CIDR block",
+			input:         "example",
+			expectedError: regexp.MustCompile(`example`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validStatelessRuleAddressDefinition(testCase.input, "address_definition")
+
+			if testCase.expectedError == nil {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+
+				return
+			}
+
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %v", errs)
+			}
+
+			if !testCase.expectedError.MatchString(errs[0].Error()) {
+				t.Fatalf("unexpected error: %s", errs[0])
+			}
+		})
+	}
+}
+
+func TestValidStatelessRulePriorities(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "empty",
+			input: []interface{}{},
+		},
+		{
+			name: "unique priorities",
+			input: []interface{}{
+				map[string]interface{}{"priority": 1},
+				map[string]interface{}{"priority": 2},
+				map[string]interface{}{"priority": 10},
+			},
+		},
+		{
+			name: "duplicate priorities",
+			input: []interface{}{
+				map[string]interface{}{"priority": 5},
+				map[string]interface{}{"priority": 1},
+				map[string]interface{}{"priority": 5},
+				map[string]interface{}{"priority": 1},
+				map[string]interface{}{"priority": 2},
+			},
+			expectedError: regexp.MustCompile(`duplicate stateless rule priorities: 1, 5$`),
+		},
+		{
+			name: "missing priority",
+			input: []interface{}{
+				map[string]interface{}{"priority": 0},
+				map[string]interface{}{"priority": 1},
+			},
+			expectedError: regexp.MustCompile(`1 stateless rule\(s\) without a priority of at least 1$`),
+		},
+		{
+			name: "missing and duplicate priorities",
+			input: []interface{}{
+				map[string]interface{}{},
+				map[string]interface{}{"priority": 3},
+				map[string]interface{}{"priority": 3},
+			},
+			expectedError: regexp.MustCompile(`1 stateless rule\(s\) without a priority of at least 1; duplicate stateless rule priorities: 3$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validStatelessRulePriorities(testCase.input)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidStatelessRuleActions(t *testing.T) {
+	t.Parallel()
+
+	statelessRule := func(priority int, actions ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"priority": priority,
+			"rule_definition": []interface{}{
+				map[string]interface{}{"actions": schema.NewSet(schema.HashString, actions)},
+			},
+		}
+	}
+
+	customActions := []interface{}{
+		map[string]interface{}{"action_name": "example"},
+	}
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		customActions []interface{}
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "empty",
+			input: []interface{}{},
+		},
+		{
+			name: "standard actions",
+			input: []interface{}{
+				statelessRule(1, "aws:pass"),
+				statelessRule(2, "aws:drop"),
+				statelessRule(3, "aws:forward_to_sfe"),
+			},
+		},
+		{
+			name: "custom action",
+			input: []interface{}{
+				statelessRule(1, "aws:pass", "example"),
+			},
+			customActions: customActions,
+		},
+		{
+			name: "misspelt standard action",
+			input: []interface{}{
+				statelessRule(1, "aws:pass"),
+				statelessRule(2, "aws:droop"),
+			},
+			customActions: customActions,
+			expectedError: regexp.MustCompile(`^invalid stateless rule actions "aws:droop" \(priority 2\): actions must be a standard action \(aws:drop, aws:forward_to_sfe, aws:pass\) or the action_name of a custom_action$`),
+		},
+		{
+			name: "undefined custom action",
+			input: []interface{}{
+				statelessRule(1, "aws:pass", "example"),
+				statelessRule(2, "aws:drop", "other"),
+			},
+			customActions: customActions,
+			expectedError: regexp.MustCompile(`^invalid stateless rule actions "other" \(priority 2\): `),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validStatelessRuleActions(testCase.input, testCase.customActions)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidStatefulRuleProtocols(t *testing.T) {
+	t.Parallel()
+
+	statefulRule := func(action, protocol string) map[string]interface{} {
+		return map[string]interface{}{
+			"action": action,
+			"header": []interface{}{
+				map[string]interface{}{"protocol": protocol},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		ruleOrder     string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "empty",
+			input: []interface{}{},
+		},
+		{
+			name: "transport protocols",
+			input: []interface{}{
+				statefulRule("DROP", "TCP"),
+				statefulRule("REJECT", "TCP"),
+				statefulRule("ALERT", "UDP"),
+				statefulRule("DROP", "IP"),
+			},
+			ruleOrder: "DEFAULT_ACTION_ORDER",
+		},
+		{
+			name: "application-layer protocol pass",
+			input: []interface{}{
+				statefulRule("PASS", "HTTP"),
+				statefulRule("PASS", "TLS"),
+			},
+		},
+		{
+			name: "application-layer protocol drop strict order",
+			input: []interface{}{
+				statefulRule("DROP", "HTTP"),
+				statefulRule("ALERT", "DNS"),
+			},
+			ruleOrder: "STRICT_ORDER",
+		},
+		{
+			name: "application-layer protocol drop default order",
+			input: []interface{}{
+				statefulRule("PASS", "HTTP"),
+				statefulRule("DROP", "TLS"),
+				statefulRule("DROP", "TCP"),
+				statefulRule("ALERT", "DNS"),
+			},
+			expectedError: regexp.MustCompile(`^stateful rules 1 \(DROP TLS\), 3 \(ALERT DNS\) use an application-layer protocol .* requires a stateful_rule_options rule_order of STRICT_ORDER$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validStatefulRuleProtocols(testCase.input, testCase.ruleOrder)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidFlowbitsSetting(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "set",
+			input: "set,bitname",
+		},
+		{
+			name:  "isset with spaces",
+			input: "isset, bitname",
+		},
+		{
+			name:  "isnotset any of several",
+			input: "isnotset,bit1|bit2",
+		},
+		{
+			name:  "toggle",
+			input: "toggle,my.bit-name_1",
+		},
+		{
+			name:  "noalert",
+			input: "noalert",
+		},
+		{
+			name:          "missing name",
+			input:         "set",
+			expectedError: regexp.MustCompile(`^flowbits setting "set": expected set,<name>$`),
+		},
+		{
+			name:          "empty name",
+			input:         "isset,",
+			expectedError: regexp.MustCompile(`^flowbits setting "isset,": expected isset,<name>$`),
+		},
+		{
+			name:          "unknown command",
+			input:         "check,bitname",
+			expectedError: regexp.MustCompile(`^flowbits setting "check,bitname": command must be one of`),
+		},
+		{
+			name:          "uppercase command",
+			input:         "SET,bitname",
+			expectedError: regexp.MustCompile(`command must be one of`),
+		},
+		{
+			name:          "name only",
+			input:         "bitname",
+			expectedError: regexp.MustCompile(`command must be one of`),
+		},
+		{
+			name:          "too many parts",
+			input:         "set,bit1,bit2",
+			expectedError: regexp.MustCompile(`expected set,<name>$`),
+		},
+		{
+			name:          "noalert with name",
+			input:         "noalert,bitname",
+			expectedError: regexp.MustCompile(`noalert does not take a flowbit name$`),
+		},
+		{
+			name:          "set several",
+			input:         "set,bit1|bit2",
+			expectedError: regexp.MustCompile(`invalid flowbit name "bit1\|bit2"$`),
+		},
+		{
+			name:          "name with semicolon",
+			input:         "set,bitname;",
+			expectedError: regexp.MustCompile(`invalid flowbit name "bitname;"$`),
+		},
+		{
+			name:          "name with space",
+			input:         "isset,bit name",
+			expectedError: regexp.MustCompile(`invalid flowbit name "bit name"$`),
+		},
+		{
+			name:          "empty alternative",
+			input:         "isset,bit1|",
+			expectedError: regexp.MustCompile(`invalid flowbit name ""$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validFlowbitsSetting(testCase.input)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidStatefulRuleOptions(t *testing.T) {
+	t.Parallel()
+
+	statefulRule := func(ruleOptions ...map[string]interface{}) map[string]interface{} {
+		tfList := make([]interface{}, len(ruleOptions))
+
+		for i, v := range ruleOptions {
+			tfList[i] = v
+		}
+
+		return map[string]interface{}{
+			"rule_option": tfList,
+		}
+	}
+	ruleOption := func(keyword string, settings ...string) map[string]interface{} {
+		return map[string]interface{}{
+			"keyword":  keyword,
+			"settings": schema.NewSet(schema.HashString, flex.FlattenStringValueList(settings)),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "empty",
+			input: []interface{}{},
+		},
+		{
+			name: "valid",
+			input: []interface{}{
+				statefulRule(ruleOption("sid", "1"), ruleOption("flowbits", "set,login")),
+				statefulRule(ruleOption("sid", "2"), ruleOption("flowbits", "isset,login"), ruleOption("flowbits", "noalert")),
+				statefulRule(ruleOption("sid", "3"), ruleOption("msg", "\"set\"")),
+			},
+		},
+		{
+			name: "malformed",
+			input: []interface{}{
+				statefulRule(ruleOption("sid", "1"), ruleOption("flowbits", "set login")),
+				statefulRule(ruleOption("sid", "2"), ruleOption("flowbits", "isset,login")),
+				statefulRule(ruleOption("sid", "3"), ruleOption("flowbits")),
+			},
+			expectedError: regexp.MustCompile(`^invalid flowbits rule options: stateful rule 0: flowbits setting "set login": command must be one of .*; stateful rule 2: flowbits rule options take exactly one setting, got 0$`),
+		},
+		{
+			name: "several settings",
+			input: []interface{}{
+				statefulRule(ruleOption("flowbits", "set,a", "set,b")),
+			},
+			expectedError: regexp.MustCompile(`^invalid flowbits rule options: stateful rule 0: flowbits rule options take exactly one setting, got 2$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validStatefulRuleOptions(testCase.input)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidStatefulRuleGroupReferences(t *testing.T) {
+	t.Parallel()
+
+	const (
+		arn1 = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/one"
+		arn2 = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/two"
+		arn3 = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/three"
+		//lintignore:AWSAT003,AWSAT005
+		managedARN = "arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/ThreatSignaturesBotnetStrictOrder"
+		//lintignore:AWSAT003,AWSAT005
+		unknownManagedARN = "arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/ThreatSignaturesBotnetStrictOrdr"
+		//lintignore:AWSAT003,AWSAT005
+		statelessManagedARN = "arn:aws:network-firewall:us-west-2:aws-managed:stateless-rulegroup/ThreatSignaturesBotnetStrictOrder"
+	)
+
+	findManaged := func(name string) (*networkfirewall.RuleGroupMetadata, error) {
+		switch name {
+		case "ThreatSignaturesBotnetStrictOrder":
+			return &networkfirewall.RuleGroupMetadata{Arn: aws.String(managedARN), Name: aws.String(name)}, nil
+		case "Unavailable":
+			return nil, errors.New("ThrottlingException")
+		}
+
+		return nil, tfresource.NewEmptyResultError(name)
+	}
+
+	reference := func(arn string, priority int) map[string]interface{} {
+		return map[string]interface{}{
+			"priority":     priority,
+			"resource_arn": arn,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		ruleOrder     string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "empty",
+			input: []interface{}{},
+		},
+		{
+			name: "default order without priorities",
+			input: []interface{}{
+				reference(arn1, 0),
+				reference(arn2, 0),
+			},
+		},
+		{
+			name: "explicit default order without priorities",
+			input: []interface{}{
+				reference(arn1, 0),
+			},
+			ruleOrder: "DEFAULT_ACTION_ORDER",
+		},
+		{
+			name: "default order with priority",
+			input: []interface{}{
+				reference(arn1, 0),
+				reference(arn2, 1),
+			},
+			ruleOrder:     "DEFAULT_ACTION_ORDER",
+			expectedError: regexp.MustCompile(`^invalid stateful rule group references: priority is only allowed with a rule_order of STRICT_ORDER: ` + arn2 + `$`),
+		},
+		{
+			name: "default order duplicate ARN",
+			input: []interface{}{
+				reference(arn1, 0),
+				reference(arn1, 0),
+			},
+			expectedError: regexp.MustCompile(`^invalid stateful rule group references: rule groups referenced more than once: ` + arn1 + `$`),
+		},
+		{
+			name: "strict order with unique priorities",
+			input: []interface{}{
+				reference(arn1, 1),
+				reference(arn2, 2),
+			},
+			ruleOrder: "STRICT_ORDER",
+		},
+		{
+			name: "strict order without priority",
+			input: []interface{}{
+				reference(arn1, 1),
+				reference(arn2, 0),
+			},
+			ruleOrder:     "STRICT_ORDER",
+			expectedError: regexp.MustCompile(`^invalid stateful rule group references: priority is required with a rule_order of STRICT_ORDER: ` + arn2 + `$`),
+		},
+		{
+			name: "strict order duplicate priority",
+			input: []interface{}{
+				reference(arn2, 5),
+				reference(arn1, 5),
+				reference(arn3, 1),
+			},
+			ruleOrder:     "STRICT_ORDER",
+			expectedError: regexp.MustCompile(`^invalid stateful rule group references: duplicate priority 5: ` + arn1 + `, ` + arn2 + `$`),
+		},
+		{
+			name: "strict order duplicate ARN",
+			input: []interface{}{
+				reference(arn1, 1),
+				reference(arn1, 2),
+			},
+			ruleOrder:     "STRICT_ORDER",
+			expectedError: regexp.MustCompile(`^invalid stateful rule group references: rule groups referenced more than once: ` + arn1 + `$`),
+		},
+		{
+			name: "unknown ARN",
+			input: []interface{}{
+				reference("", 0),
+				reference("74D93920-ED26-11E3-AC10-0800200C9A66", 0),
+			},
+			ruleOrder: "STRICT_ORDER",
+		},
+		{
+			name: "managed rule group",
+			input: []interface{}{
+				reference(arn1, 1),
+				reference(managedARN, 2),
+			},
+			ruleOrder: "STRICT_ORDER",
+		},
+		{
+			name: "unknown managed rule group",
+			input: []interface{}{
+				reference(managedARN, 1),
+				reference(unknownManagedARN, 2),
+				reference(statelessManagedARN, 3),
+			},
+			ruleOrder:     "STRICT_ORDER",
+			expectedError: regexp.MustCompile(`^invalid stateful rule group references: not stateful AWS managed rule groups: ` + unknownManagedARN + `, ` + statelessManagedARN + `$`),
+		},
+		{
+			name: "managed rule group lookup error",
+			input: []interface{}{
+				reference("arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/Unavailable", 0), //lintignore:AWSAT003,AWSAT005
+			},
+			expectedError: regexp.MustCompile(`ThrottlingException`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validStatefulRuleGroupReferences(testCase.input, testCase.ruleOrder, findManaged)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidRulesSourceList(t *testing.T) {
+	t.Parallel()
+
+	rulesSourceList := map[string]interface{}{
+		"generated_rules_type": "DENYLIST",
+		"target_types":         schema.NewSet(schema.HashString, []interface{}{"HTTP_HOST", "TLS_SNI"}),
+		"targets":              schema.NewSet(schema.HashString, []interface{}{"example.com"}),
+	}
+
+	testCases := []struct {
+		name          string
+		input         map[string]interface{}
+		ruleGroupType string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:          "stateful",
+			input:         rulesSourceList,
+			ruleGroupType: "STATEFUL",
+		},
+		{
+			name:          "stateless",
+			input:         rulesSourceList,
+			ruleGroupType: "STATELESS",
+			expectedError: regexp.MustCompile(`^invalid rules source list: domain lists can only be specified for STATEFUL rule groups$`),
+		},
+		{
+			name:          "stateless no rules source list",
+			ruleGroupType: "STATELESS",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validRulesSourceList(testCase.input, testCase.ruleGroupType)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRulesSourceListDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	rulesSourceList := func(generatedRulesType string, targetTypes ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"generated_rules_type": generatedRulesType,
+			"target_types":         schema.NewSet(schema.HashString, targetTypes),
+			"targets":              schema.NewSet(schema.HashString, []interface{}{".example.com"}),
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		input           map[string]interface{}
+		expectedWarning *regexp.Regexp
+	}{
+		{
+			name: "no rules source list",
+		},
+		{
+			name:  "allowlist",
+			input: rulesSourceList("ALLOWLIST", "HTTP_HOST", "TLS_SNI"),
+		},
+		{
+			name:  "denylist",
+			input: rulesSourceList("DENYLIST", "HTTP_HOST", "TLS_SNI"),
+		},
+		{
+			name:            "allowlist HTTP_HOST only",
+			input:           rulesSourceList("ALLOWLIST", "HTTP_HOST"),
+			expectedWarning: regexp.MustCompile(`only HTTP traffic is restricted to the targets; HTTPS traffic to any domain is not matched`),
+		},
+		{
+			name:            "allowlist TLS_SNI only",
+			input:           rulesSourceList("ALLOWLIST", "TLS_SNI"),
+			expectedWarning: regexp.MustCompile(`only HTTPS traffic is restricted to the targets; HTTP traffic to any domain is not matched`),
+		},
+		{
+			name:            "denylist HTTP_HOST only",
+			input:           rulesSourceList("DENYLIST", "HTTP_HOST"),
+			expectedWarning: regexp.MustCompile(`only HTTP traffic to the targets is denied; HTTPS traffic to the same domains is not matched`),
+		},
+		{
+			name:            "denylist TLS_SNI only",
+			input:           rulesSourceList("DENYLIST", "TLS_SNI"),
+			expectedWarning: regexp.MustCompile(`only HTTPS traffic to the targets is denied; HTTP traffic to the same domains is not matched`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := rulesSourceListDiagnostics(testCase.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if testCase.expectedWarning == nil {
+				if len(diags) != 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Severity != diag.Warning {
+				t.Fatalf("expected a warning, got severity %v", diags[0].Severity)
+			}
+
+			if !testCase.expectedWarning.MatchString(diags[0].Detail) {
+				t.Fatalf("unexpected warning: %s", diags[0].Detail)
+			}
+		})
+	}
+}
+
+// testIPSet returns an IP set variable, in the form accepted by expandIPSets, whose definition has n distinct IPv4 and IPv6 CIDR blocks.
+func testIPSet(key string, n int) map[string]interface{} {
+	definition := make([]interface{}, n)
+
+	for i := range definition {
+		if i%2 == 0 {
+			definition[i] = fmt.Sprintf("10.%d.%d.0/24", (i>>8)&0xff, i&0xff)
+		} else {
+			definition[i] = fmt.Sprintf("2001:db8:%x::/48", i)
+		}
+	}
+
+	return map[string]interface{}{
+		"key": key,
+		"ip_set": []interface{}{
+			map[string]interface{}{
+				"definition": schema.NewSet(schema.HashString, definition),
+			},
+		},
+	}
+}
+
+func TestValidRuleVariablesIPSets(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		expectedError *regexp.Regexp
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "small",
+			input: []interface{}{testIPSet("HOME_NET", 2)},
+		},
+		{
+			name:  "maximum",
+			input: []interface{}{testIPSet("HOME_NET", ruleVariablesIPSetDefinitionMaxItems), testIPSet("EXTERNAL_NET", ruleVariablesIPSetDefinitionMaxItems)},
+		},
+		{
+			name:          "over maximum",
+			input:         []interface{}{testIPSet("HOME_NET", 2), testIPSet("EXTERNAL_NET", ruleVariablesIPSetDefinitionMaxItems+1)},
+			expectedError: regexp.MustCompile(`^IP sets EXTERNAL_NET \(10001\) define more than the maximum of 10000 CIDR blocks`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validRuleVariablesIPSets(testCase.input)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+// TestValidRuleVariablesIPSetDefinitionLarge validates the CIDR blocks of IP sets of increasing size.
+// Validation is per element, so the time taken should grow linearly with the number of CIDR blocks.
+func TestValidRuleVariablesIPSetDefinitionLarge(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1000, ruleVariablesIPSetDefinitionMaxItems} {
+		tfMap := testIPSet("HOME_NET", n)
+
+		if got := ipSetDefinitionLen(tfMap); got != n {
+			t.Fatalf("got %d CIDR blocks, expected %d", got, n)
+		}
+
+		for _, v := range tfMap["ip_set"].([]interface{})[0].(map[string]interface{})["definition"].(*schema.Set).List() {
+			if _, errs := verify.ValidIPv46CIDRNetworkAddress(v, "definition"); len(errs) > 0 {
+				t.Fatalf("unexpected errors for %s: %v", v, errs)
+			}
+		}
+	}
+}
+
+func TestValidRulesStringVariableReferences(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		rules         string
+		ipSets        []string
+		portSets      []string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "no variables",
+			rules: `pass tcp 10.0.0.0/8 any -> 192.168.0.0/16 443 (sid:1;)`,
+		},
+		{
+			name:  "implicit variables",
+			rules: `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"example.com"; sid:1;)`,
+		},
+		{
+			name:     "defined variables",
+			rules:    "pass tcp [$SOURCE,!10.0.1.0/24] any -> $HOME_NET $WEB_PORTS (sid:1;)\n# alert tcp $UNDEFINED any -> any any (sid:2;)",
+			ipSets:   []string{"SOURCE"},
+			portSets: []string{"WEB_PORTS"},
+		},
+		{
+			name:  "dollar in rule option",
+			rules: `alert http any any -> any any (http.uri; content:"$PATH"; sid:1;)`,
+		},
+		{
+			name:  "unparseable rules",
+			rules: `pass tcp $SOURCE any`,
+		},
+		{
+			name:          "undefined variables",
+			rules:         "pass tcp $SOURCE any -> $DESTINATION $WEB_PORTS (sid:1;)\npass tcp $SOURCE $WEB_PORTS -> any any (sid:2;)",
+			ipSets:        []string{"DESTINATION"},
+			expectedError: regexp.MustCompile(`^rules reference undefined rule variables \$SOURCE \(ip_sets\), \$WEB_PORTS \(port_sets\); define them in rule_group.0.rule_variables$`),
+		},
+		{
+			name:          "port set used as IP set",
+			rules:         `pass tcp $WEB_PORTS any -> any any (sid:1;)`,
+			portSets:      []string{"WEB_PORTS"},
+			expectedError: regexp.MustCompile(`\$WEB_PORTS \(ip_sets\)`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validRulesStringVariableReferences(testCase.rules, testCase.ipSets, testCase.portSets)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
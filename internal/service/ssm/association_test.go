@@ -608,6 +608,50 @@ func TestAccSSMAssociation_rateControl(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_automationRateControl(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_automationRateControl(rName, "10%", "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "AWS-RestartEC2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "automation_target_parameter_name", "InstanceId"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "10%"),
+					resource.TestCheckResourceAttr(resourceName, "max_errors", "0"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.key", "tag:Name"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.values.0", rName),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.values.1", fmt.Sprintf("%s-2", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_automationRateControl(rName, "2", "50%"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automation_target_parameter_name", "InstanceId"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_errors", "50%"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1569,6 +1613,22 @@ resource "aws_ssm_association" "test" {
 `, rName, rate)
 }
 
+func testAccAssociationConfig_automationRateControl(rName, maxConcurrency, maxErrors string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-RestartEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+  max_concurrency                  = %[2]q
+  max_errors                       = %[3]q
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q, "%[1]s-2"]
+  }
+}
+`, rName, maxConcurrency, maxErrors)
+}
+
 func testAccAssociationConfig_outputLocationAndWaitForSuccess(rName string) string {
 	return acctest.ConfigCompose(
 		testAccAssociationWithOutputLocationS3RegionConfigBase(rName),
@@ -172,6 +172,27 @@ func ResourceDocument() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"requires": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"schema_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -262,6 +283,10 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Attachments = expandAttachmentsSources(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("requires"); ok && len(v.([]interface{})) > 0 {
+		input.Requires = expandDocumentRequireses(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("target_type"); ok {
 		input.TargetType = aws.String(v.(string))
 	}
@@ -346,6 +371,9 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
 	d.Set("platform_types", aws.StringValueSlice(doc.PlatformTypes))
+	if err := d.Set("requires", flattenDocumentRequireses(doc.Requires)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting requires: %s", err)
+	}
 	d.Set("schema_version", doc.SchemaVersion)
 	d.Set("status", doc.Status)
 	d.Set("target_type", doc.TargetType)
@@ -368,25 +396,13 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	{
-		input := &ssm.DescribeDocumentPermissionInput{
-			Name:           aws.String(d.Id()),
-			PermissionType: aws.String(ssm.DocumentPermissionTypeShare),
-		}
-
-		output, err := conn.DescribeDocumentPermissionWithContext(ctx, input)
+		accountsIDs, err := findDocumentPermissionAccountIDs(ctx, conn, d.Id())
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s) permissions: %s", d.Id(), err)
 		}
 
-		if accountsIDs := aws.StringValueSlice(output.AccountIds); len(accountsIDs) > 0 {
-			d.Set("permissions", map[string]string{
-				"account_ids": strings.Join(accountsIDs, ","),
-				"type":        ssm.DocumentPermissionTypeShare,
-			})
-		} else {
-			d.Set("permissions", nil)
-		}
+		d.Set("permissions", flattenDocumentPermissions(accountsIDs))
 	}
 
 	SetTagsOut(ctx, doc.Tags)
@@ -632,6 +648,17 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.SSM, name string) (*ssm.
 	return nil, err
 }
 
+func flattenDocumentPermissions(accountIDs []string) map[string]string {
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	return map[string]string{
+		"account_ids": strings.Join(accountIDs, ","),
+		"type":        ssm.DocumentPermissionTypeShare,
+	}
+}
+
 func expandAttachmentsSource(tfMap map[string]interface{}) *ssm.AttachmentsSource {
 	if tfMap == nil {
 		return nil
@@ -680,6 +707,86 @@ func expandAttachmentsSources(tfList []interface{}) []*ssm.AttachmentsSource {
 	return apiObjects
 }
 
+func expandDocumentRequires(tfMap map[string]interface{}) *ssm.DocumentRequires {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssm.DocumentRequires{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDocumentRequireses(tfList []interface{}) []*ssm.DocumentRequires {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssm.DocumentRequires
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandDocumentRequires(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDocumentRequires(apiObject *ssm.DocumentRequires) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap["version"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDocumentRequireses(apiObjects []*ssm.DocumentRequires) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenDocumentRequires(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenDocumentParameter(apiObject *ssm.DocumentParameter) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // @SDKDataSource("aws_ssm_document")
@@ -47,6 +48,12 @@ func DataSourceDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"permissions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -54,6 +61,7 @@ func DataSourceDocument() *schema.Resource {
 func dataDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	name := d.Get("name").(string)
 	input := &ssm.GetDocumentInput{
@@ -91,5 +99,42 @@ func dataDocumentRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("document_version", output.DocumentVersion)
 	d.Set("name", output.Name)
 
+	// Permissions and tags can only be read for documents owned by the caller,
+	// not for documents owned by Amazon or shared from other accounts.
+	var owned bool
+
+	if !strings.HasPrefix(name, "AWS-") {
+		document, err := FindDocumentByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s): %s", name, err)
+		}
+
+		owned = aws.StringValue(document.Owner) == meta.(*conns.AWSClient).AccountID
+	}
+
+	if owned {
+		accountIDs, err := findDocumentPermissionAccountIDs(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s) permissions: %s", name, err)
+		}
+
+		d.Set("permissions", flattenDocumentPermissions(accountIDs))
+
+		tags, err := ListTags(ctx, conn, d.Id(), ssm.ResourceTypeForTaggingDocument)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing tags for SSM Document (%s): %s", name, err)
+		}
+
+		if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+		}
+	} else {
+		d.Set("permissions", nil)
+		d.Set("tags", nil)
+	}
+
 	return diags
 }
@@ -30,6 +30,8 @@ func TestAccSSMDocumentDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "document_version", "1"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "document_type", resourceName, "document_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "content", resourceName, "content"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 				),
 			},
 			{
@@ -47,6 +49,32 @@ func TestAccSSMDocumentDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocumentDataSource_permissionsAndTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_document.test"
+	resourceName := "aws_ssm_document.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentDataSourceConfig_permissionsAndTags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.%", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "permissions.account_ids", resourceName, "permissions.account_ids"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.type", ssm.DocumentPermissionTypeShare),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocumentDataSource_managed(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ssm_document.test"
@@ -61,6 +89,29 @@ func TestAccSSMDocumentDataSource_managed(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "name", "AWS-StartEC2Instance"),
 					resource.TestCheckResourceAttr(dataSourceName, "arn", "AWS-StartEC2Instance"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMDocumentDataSource_amazonOwned(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentDataSourceConfig_amazonOwned(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", "AmazonCloudWatch-ManageAgent"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 				),
 			},
 		},
@@ -101,6 +152,49 @@ data "aws_ssm_document" "test" {
 `, rName, documentFormat)
 }
 
+func testAccDocumentDataSourceConfig_permissionsAndTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  permissions = {
+    type        = "Share"
+    account_ids = "123456789012,123456789013"
+  }
+
+  tags = {
+    key1 = "value1"
+    key2 = "value2"
+  }
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+}
+
+data "aws_ssm_document" "test" {
+  name = aws_ssm_document.test.name
+}
+`, rName)
+}
+
 func testAccDocumentDataSourceConfig_managed() string {
 	return `
 data "aws_ssm_document" "test" {
@@ -108,3 +202,11 @@ data "aws_ssm_document" "test" {
 }
 `
 }
+
+func testAccDocumentDataSourceConfig_amazonOwned() string {
+	return `
+data "aws_ssm_document" "test" {
+  name = "AmazonCloudWatch-ManageAgent"
+}
+`
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -116,6 +117,71 @@ func TestAccSSMDocument_Target_type(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_requires(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_requires(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_type", "ApplicationConfiguration"),
+					resource.TestCheckResourceAttr(resourceName, "requires.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "requires.0.name", "aws_ssm_document.schema", "name"),
+					resource.TestCheckResourceAttr(resourceName, "requires.0.version", "$DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "/AWS::AppConfig::Application"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_requiresAWSOwnedSchema(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	// The AWS-owned schema document is looked up before the test case is built as the configuration depends on its name.
+	acctest.PreCheck(ctx, t)
+	schemaName := testAccAWSOwnedDocumentName(ctx, t, ssm.DocumentTypeApplicationConfigurationSchema)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_requiresAWSOwnedSchema(rName, schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_type", "ApplicationConfiguration"),
+					resource.TestCheckResourceAttr(resourceName, "requires.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requires.0.name", schemaName),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "/AWS::AppConfig::Application"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_versionName(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -719,6 +785,115 @@ DOC
 `, rName, typ)
 }
 
+func testAccDocumentConfig_requires(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "schema" {
+  name          = "%[1]s-schema"
+  document_type = "ApplicationConfigurationSchema"
+
+  content = <<DOC
+{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "type": "object",
+  "properties": {
+    "Setting": {
+      "type": "string"
+    }
+  },
+  "required": ["Setting"]
+}
+DOC
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "ApplicationConfiguration"
+  target_type   = "/AWS::AppConfig::Application"
+
+  requires {
+    name    = aws_ssm_document.schema.name
+    version = "$DEFAULT"
+  }
+
+  content = <<DOC
+{
+  "Setting": "value"
+}
+DOC
+}
+`, rName)
+}
+
+// testAccAWSOwnedDocumentName returns the name of an AWS-owned SSM document of the specified type,
+// skipping the test if there is none in the current region.
+func testAccAWSOwnedDocumentName(ctx context.Context, t *testing.T, documentType string) string {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+	input := &ssm.ListDocumentsInput{
+		Filters: []*ssm.DocumentKeyValuesFilter{
+			{
+				Key:    aws.String("Owner"),
+				Values: aws.StringSlice([]string{"Amazon"}),
+			},
+			{
+				Key:    aws.String("DocumentType"),
+				Values: aws.StringSlice([]string{documentType}),
+			},
+		},
+	}
+	var name string
+
+	err := conn.ListDocumentsPagesWithContext(ctx, input, func(page *ssm.ListDocumentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DocumentIdentifiers {
+			if v != nil && aws.StringValue(v.Name) != "" {
+				name = aws.StringValue(v.Name)
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("listing AWS-owned SSM Documents (%s): %s", documentType, err)
+	}
+
+	if name == "" {
+		t.Skipf("skipping acceptance testing: no AWS-owned SSM Document of type %s found", documentType)
+	}
+
+	return name
+}
+
+func testAccDocumentConfig_requiresAWSOwnedSchema(rName, schemaName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "ApplicationConfiguration"
+  target_type   = "/AWS::AppConfig::Application"
+
+  requires {
+    name = %[2]q
+  }
+
+  content = <<DOC
+{}
+DOC
+}
+`, rName, schemaName)
+}
+
 func testAccDocumentConfig_basicVersionName(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
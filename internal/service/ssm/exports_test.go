@@ -0,0 +1,6 @@
+package ssm
+
+// Exports for use in tests only.
+var (
+	ValidParameterValueAllowedPattern = validParameterValueAllowedPattern
+)
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -63,6 +64,122 @@ func FindPatchGroup(ctx context.Context, conn *ssm.SSM, patchGroup, baselineId s
 	return result, err
 }
 
+// findDocumentPermissionAccountIDs returns the IDs of the accounts that the SSM Document with the specified name is shared with.
+func findDocumentPermissionAccountIDs(ctx context.Context, conn *ssm.SSM, name string) ([]string, error) {
+	input := &ssm.DescribeDocumentPermissionInput{
+		Name:           aws.String(name),
+		PermissionType: aws.String(ssm.DocumentPermissionTypeShare),
+	}
+	var result []string
+
+	for {
+		output, err := conn.DescribeDocumentPermissionWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		result = append(result, aws.StringValueSlice(output.AccountIds)...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
+
+// findPatchBaselineForPatchGroup returns the patch baseline that applies to the specified patch group.
+// If no baseline is registered for the patch group, the default baseline for the operating system applies.
+// The operating system defaults to WINDOWS.
+func findPatchBaselineForPatchGroup(ctx context.Context, conn *ssm.SSM, patchGroup, operatingSystem string) (*ssm.GetPatchBaselineForPatchGroupOutput, error) {
+	input := &ssm.GetPatchBaselineForPatchGroupInput{
+		PatchGroup: aws.String(patchGroup),
+	}
+
+	if operatingSystem != "" {
+		input.OperatingSystem = aws.String(operatingSystem)
+	}
+
+	output, err := conn.GetPatchBaselineForPatchGroupWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BaselineId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findInstancePatchStatesByInstanceIDs returns the patch states of the specified managed instances.
+// Instances that have not reported a patch state are omitted.
+func findInstancePatchStatesByInstanceIDs(ctx context.Context, conn *ssm.SSM, instanceIDs []string) ([]*ssm.InstancePatchState, error) {
+	// DescribeInstancePatchStates accepts at most 50 instance IDs per request.
+	const batchSize = 50
+	var result []*ssm.InstancePatchState
+
+	for _, chunk := range slices.Chunks(instanceIDs, batchSize) {
+		input := &ssm.DescribeInstancePatchStatesInput{
+			InstanceIds: aws.StringSlice(chunk),
+		}
+
+		err := conn.DescribeInstancePatchStatesPagesWithContext(ctx, input, func(page *ssm.DescribeInstancePatchStatesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, instancePatchState := range page.InstancePatchStates {
+				if instancePatchState != nil {
+					result = append(result, instancePatchState)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// findPatchGroupsByBaselineID returns the names of the patch groups registered to the specified patch baseline.
+func findPatchGroupsByBaselineID(ctx context.Context, conn *ssm.SSM, baselineID string) ([]string, error) {
+	input := &ssm.DescribePatchGroupsInput{}
+	var result []string
+
+	err := conn.DescribePatchGroupsPagesWithContext(ctx, input, func(page *ssm.DescribePatchGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, mapping := range page.Mappings {
+			if mapping == nil || mapping.BaselineIdentity == nil {
+				continue
+			}
+
+			if aws.StringValue(mapping.BaselineIdentity.BaselineId) == baselineID {
+				result = append(result, aws.StringValue(mapping.PatchGroup))
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
 func FindServiceSettingByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.ServiceSetting, error) {
 	input := &ssm.GetServiceSettingInput{
 		SettingId: aws.String(id),
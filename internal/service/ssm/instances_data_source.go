@@ -2,6 +2,7 @@ package ssm
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -17,6 +18,66 @@ func DataSourceInstances() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceInstancesRead,
 		Schema: map[string]*schema.Schema{
+			"compliance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"baseline_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failed_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"installed_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"installed_other_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"installed_pending_reboot_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"installed_rejected_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"missing_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"not_applicable_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"operation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operation_end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operation_start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"patch_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"filter": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -40,6 +101,10 @@ func DataSourceInstances() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"include_compliance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -85,6 +150,20 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set("ids", instanceIDs)
 
+	if d.Get("include_compliance").(bool) && len(instanceIDs) > 0 {
+		instancePatchStates, err := findInstancePatchStatesByInstanceIDs(ctx, conn, instanceIDs)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Instance patch states: %s", err)
+		}
+
+		if err := d.Set("compliance", flattenInstancePatchStates(instancePatchStates)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting compliance: %s", err)
+		}
+	} else {
+		d.Set("compliance", nil)
+	}
+
 	return diags
 }
 
@@ -131,3 +210,51 @@ func expandInstanceInformationStringFilter(tfMap map[string]interface{}) *ssm.In
 
 	return apiObject
 }
+
+func flattenInstancePatchStates(apiObjects []*ssm.InstancePatchState) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenInstancePatchState(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenInstancePatchState(apiObject *ssm.InstancePatchState) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"baseline_id":                    aws.StringValue(apiObject.BaselineId),
+		"failed_count":                   aws.Int64Value(apiObject.FailedCount),
+		"installed_count":                aws.Int64Value(apiObject.InstalledCount),
+		"installed_other_count":          aws.Int64Value(apiObject.InstalledOtherCount),
+		"installed_pending_reboot_count": aws.Int64Value(apiObject.InstalledPendingRebootCount),
+		"installed_rejected_count":       aws.Int64Value(apiObject.InstalledRejectedCount),
+		"instance_id":                    aws.StringValue(apiObject.InstanceId),
+		"missing_count":                  aws.Int64Value(apiObject.MissingCount),
+		"not_applicable_count":           aws.Int64Value(apiObject.NotApplicableCount),
+		"operation":                      aws.StringValue(apiObject.Operation),
+		"patch_group":                    aws.StringValue(apiObject.PatchGroup),
+	}
+
+	if v := apiObject.OperationEndTime; v != nil {
+		tfMap["operation_end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.OperationStartTime; v != nil {
+		tfMap["operation_start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -3,6 +3,7 @@ package ssm_test
 import (
 	"fmt"
 	"log"
+	"os"
 	"testing"
 	"time"
 
@@ -47,6 +48,38 @@ func TestAccSSMInstancesDataSource_filter(t *testing.T) {
 	})
 }
 
+func TestAccSSMInstancesDataSource_includeCompliance(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "SSM_PATCHED_INSTANCE_ID"
+	instanceID := os.Getenv(key)
+	if instanceID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	dataSourceName := "data.aws_ssm_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_includeCompliance(instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "compliance.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance.0.baseline_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance.0.installed_count"),
+					resource.TestCheckResourceAttr(dataSourceName, "compliance.0.instance_id", instanceID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance.0.missing_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance.0.operation"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance.0.operation_end_time"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstancesDataSourceConfig_filterInstance(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
@@ -172,3 +205,16 @@ data "aws_ssm_instances" "test" {
 }
 `)
 }
+
+func testAccInstancesDataSourceConfig_includeCompliance(instanceID string) string {
+	return fmt.Sprintf(`
+data "aws_ssm_instances" "test" {
+  include_compliance = true
+
+  filter {
+    name   = "InstanceIds"
+    values = [%[1]q]
+  }
+}
+`, instanceID)
+}
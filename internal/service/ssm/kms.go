@@ -0,0 +1,133 @@
+package ssm
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+// kmsKeyARNCaches holds a key ARN cache for each KMS connection, i.e. for each provider configuration.
+// The provider runs for a single Terraform operation, so an alias that's retargeted resolves to its new key on the next run.
+var kmsKeyARNCaches = struct {
+	sync.Mutex
+	caches map[*kms.KMS]*kmsKeyARNCache
+}{
+	caches: make(map[*kms.KMS]*kmsKeyARNCache),
+}
+
+// findKMSKeyARNFunc returns a function that resolves KMS key identifiers (key IDs, key ARNs, alias names and alias ARNs) to key ARNs.
+// The key ARNs are cached by identifier, so each identifier is looked up once per Terraform operation.
+func findKMSKeyARNFunc(conn *kms.KMS) func(context.Context, string) (string, error) {
+	kmsKeyARNCaches.Lock()
+	cache, ok := kmsKeyARNCaches.caches[conn]
+	if !ok {
+		cache = newKMSKeyARNCache()
+		kmsKeyARNCaches.caches[conn] = cache
+	}
+	kmsKeyARNCaches.Unlock()
+
+	find := func(ctx context.Context, keyID string) (string, error) {
+		output, err := conn.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+			KeyId: aws.String(keyID),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.StringValue(output.KeyMetadata.Arn), nil
+	}
+
+	return func(ctx context.Context, keyID string) (string, error) {
+		return cache.resolve(ctx, keyID, find)
+	}
+}
+
+// kmsKeyARNCache caches the key ARNs that KMS key identifiers resolve to.
+type kmsKeyARNCache struct {
+	mu      sync.Mutex
+	keyARNs map[string]string
+}
+
+func newKMSKeyARNCache() *kmsKeyARNCache {
+	return &kmsKeyARNCache{
+		keyARNs: make(map[string]string),
+	}
+}
+
+// resolve returns the key ARN that keyID resolves to, calling find if it isn't cached.
+// Errors aren't cached, so a failed lookup is retried the next time.
+func (c *kmsKeyARNCache) resolve(ctx context.Context, keyID string, find func(context.Context, string) (string, error)) (string, error) {
+	c.mu.Lock()
+	keyARN, ok := c.keyARNs[keyID]
+	c.mu.Unlock()
+
+	if ok {
+		return keyARN, nil
+	}
+
+	keyARN, err := find(ctx, keyID)
+
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.keyARNs[keyID] = keyARN
+	c.mu.Unlock()
+
+	return keyARN, nil
+}
+
+// sameKMSKey returns whether two KMS key identifiers refer to the same key.
+// Identifiers that can't be compared directly are resolved to key ARNs using resolve.
+func sameKMSKey(ctx context.Context, a, b string, resolve func(context.Context, string) (string, error)) (bool, error) {
+	if a == b {
+		return true, nil
+	}
+
+	if a == "" || b == "" {
+		return false, nil
+	}
+
+	// A key ID and the corresponding key ARN.
+	if keyID, ok := kmsKeyIDFromARN(a); ok && keyID == b {
+		return true, nil
+	}
+
+	if keyID, ok := kmsKeyIDFromARN(b); ok && keyID == a {
+		return true, nil
+	}
+
+	keyARNA, err := resolve(ctx, a)
+
+	if err != nil {
+		return false, err
+	}
+
+	keyARNB, err := resolve(ctx, b)
+
+	if err != nil {
+		return false, err
+	}
+
+	return keyARNA == keyARNB, nil
+}
+
+func kmsKeyIDFromARN(s string) (string, bool) {
+	v, err := arn.Parse(s)
+
+	if err != nil || v.Service != "kms" {
+		return "", false
+	}
+
+	if !strings.HasPrefix(v.Resource, "key/") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(v.Resource, "key/"), true
+}
@@ -0,0 +1,166 @@
+package ssm
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSameKMSKey(t *testing.T) {
+	t.Parallel()
+
+	const (
+		keyID1  = "1234abcd-12ab-34cd-56ef-1234567890ab"
+		keyARN1 = "arn:aws:kms:us-west-2:123456789012:key/" + keyID1
+		keyID2  = "0987dcba-09fe-87dc-65ba-ab0987654321"
+		keyARN2 = "arn:aws:kms:us-west-2:123456789012:key/" + keyID2
+	)
+
+	keyARNs := map[string]string{
+		keyID1:                 keyARN1,
+		keyARN1:                keyARN1,
+		"alias/example":        keyARN1,
+		"alias/rotated":        keyARN2,
+		"alias/aws/ssm":        keyARN2,
+		keyID2:                 keyARN2,
+		keyARN2:                keyARN2,
+		"alias/another-target": keyARN2,
+	}
+
+	resolve := func(_ context.Context, keyID string) (string, error) {
+		if keyARN, ok := keyARNs[keyID]; ok {
+			return keyARN, nil
+		}
+
+		return "", errors.New("NotFoundException")
+	}
+
+	testCases := []struct {
+		name          string
+		a, b          string
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:     "identical",
+			a:        "alias/example",
+			b:        "alias/example",
+			expected: true,
+		},
+		{
+			name: "empty",
+			a:    "",
+			b:    keyARN1,
+		},
+		{
+			name:     "key ID and key ARN",
+			a:        keyID1,
+			b:        keyARN1,
+			expected: true,
+		},
+		{
+			name:     "key ARN and key ID",
+			a:        keyARN1,
+			b:        keyID1,
+			expected: true,
+		},
+		{
+			name:     "alias and key ARN",
+			a:        "alias/example",
+			b:        keyARN1,
+			expected: true,
+		},
+		{
+			name:     "aliases of the same key",
+			a:        "alias/rotated",
+			b:        "alias/aws/ssm",
+			expected: true,
+		},
+		{
+			name: "alias of a different key",
+			a:    "alias/rotated",
+			b:    keyARN1,
+		},
+		{
+			name: "different keys",
+			a:    keyID1,
+			b:    keyARN2,
+		},
+		{
+			name:          "unresolvable",
+			a:             "alias/missing",
+			b:             keyARN1,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sameKMSKey(context.Background(), testCase.a, testCase.b, resolve)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestKMSKeyARNCache(t *testing.T) {
+	t.Parallel()
+
+	const keyARN = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	calls := make(map[string]int)
+	find := func(_ context.Context, keyID string) (string, error) {
+		calls[keyID]++
+
+		if keyID == "alias/missing" {
+			return "", errors.New("NotFoundException")
+		}
+
+		return keyARN, nil
+	}
+
+	ctx := context.Background()
+	cache := newKMSKeyARNCache()
+
+	for i := 0; i < 2; i++ {
+		got, err := cache.resolve(ctx, "alias/example", find)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != keyARN {
+			t.Errorf("got %s, expected %s", got, keyARN)
+		}
+
+		if _, err := cache.resolve(ctx, "alias/missing", find); err == nil {
+			t.Fatal("expected error, got none")
+		}
+	}
+
+	if got, expected := calls["alias/example"], 1; got != expected {
+		t.Errorf("got %d lookups of alias/example, expected %d", got, expected)
+	}
+
+	if got, expected := calls["alias/missing"], 2; got != expected {
+		t.Errorf("got %d lookups of alias/missing, expected %d", got, expected)
+	}
+}
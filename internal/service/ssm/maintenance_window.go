@@ -63,6 +63,10 @@ func ResourceMaintenanceWindow() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"next_execution_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"schedule": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -169,6 +173,7 @@ func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("enabled", output.Enabled)
 	d.Set("end_date", output.EndDate)
 	d.Set("name", output.Name)
+	d.Set("next_execution_time", output.NextExecutionTime)
 	d.Set("schedule", output.Schedule)
 	d.Set("schedule_offset", output.ScheduleOffset)
 	d.Set("schedule_timezone", output.ScheduleTimezone)
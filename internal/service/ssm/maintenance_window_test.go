@@ -3,9 +3,11 @@ package ssm_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -271,7 +273,7 @@ func TestAccSSMMaintenanceWindow_duration(t *testing.T) {
 
 func TestAccSSMMaintenanceWindow_enabled(t *testing.T) {
 	ctx := acctest.Context(t)
-	var maintenanceWindow1, maintenanceWindow2 ssm.GetMaintenanceWindowOutput
+	var maintenanceWindow1, maintenanceWindow2, maintenanceWindow3 ssm.GetMaintenanceWindowOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_maintenance_window.test"
 
@@ -297,7 +299,17 @@ func TestAccSSMMaintenanceWindow_enabled(t *testing.T) {
 				Config: testAccMaintenanceWindowConfig_enabled(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMaintenanceWindowExists(ctx, resourceName, &maintenanceWindow2),
+					testAccCheckMaintenanceWindowNotRecreated(&maintenanceWindow1, &maintenanceWindow2),
 					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "next_execution_time", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+				),
+			},
+			{
+				Config: testAccMaintenanceWindowConfig_enabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaintenanceWindowExists(ctx, resourceName, &maintenanceWindow3),
+					testAccCheckMaintenanceWindowNotRecreated(&maintenanceWindow2, &maintenanceWindow3),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
 				),
 			},
 		},
@@ -529,6 +541,16 @@ func testAccCheckMaintenanceWindowExists(ctx context.Context, n string, v *ssm.G
 	}
 }
 
+func testAccCheckMaintenanceWindowNotRecreated(i, j *ssm.GetMaintenanceWindowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(i.WindowId), aws.StringValue(j.WindowId); before != after {
+			return fmt.Errorf("SSM Maintenance Window (%s) recreated as (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckMaintenanceWindowDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
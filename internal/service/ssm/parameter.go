@@ -2,8 +2,10 @@ package ssm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -88,6 +91,16 @@ func ResourceParameter() *schema.Resource {
 				Optional:   true,
 				Deprecated: "this attribute has been deprecated",
 			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tier": {
@@ -135,12 +148,103 @@ func ResourceParameter() *schema.Resource {
 			customdiff.ComputedIf("insecure_value", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("value")
 			}),
+			resourceParameterCustomizeDiffKeyID,
+			resourceParameterCustomizeDiffAllowedPattern,
+			resourceParameterCustomizeDiffPolicies,
 
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+// resourceParameterCustomizeDiffKeyID suppresses changes to key_id between identifiers, such as an alias and a key ARN,
+// that refer to the same KMS key.
+func resourceParameterCustomizeDiffKeyID(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("key_id") || !diff.NewValueKnown("key_id") {
+		return nil
+	}
+
+	if diff.Get("type").(string) != ssm.ParameterTypeSecureString {
+		return nil
+	}
+
+	o, n := diff.GetChange("key_id")
+	old, new := o.(string), n.(string)
+
+	if old == "" || new == "" {
+		return nil
+	}
+
+	same, err := sameKMSKey(ctx, old, new, findKMSKeyARNFunc(meta.(*conns.AWSClient).KMSConn()))
+
+	if err != nil {
+		log.Printf("[WARN] Unable to compare SSM Parameter (%s) KMS keys %q and %q: %s", diff.Id(), old, new, err)
+		return nil
+	}
+
+	if same {
+		return diff.Clear("key_id")
+	}
+
+	return nil
+}
+
+// resourceParameterCustomizeDiffAllowedPattern validates the configured value against allowed_pattern
+// when both are known, rather than failing on PutParameter.
+func resourceParameterCustomizeDiffAllowedPattern(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	pattern := config.GetAttr("allowed_pattern")
+	if !pattern.IsKnown() || pattern.IsNull() {
+		return nil
+	}
+
+	value := config.GetAttr("value")
+	if v := config.GetAttr("insecure_value"); !v.IsKnown() || !v.IsNull() {
+		value = v
+	}
+	if !value.IsKnown() || value.IsNull() {
+		return nil
+	}
+
+	return validParameterValueAllowedPattern(value.AsString(), pattern.AsString())
+}
+
+// resourceParameterCustomizeDiffPolicies prevents policies from being set on a parameter in the standard tier.
+func resourceParameterCustomizeDiffPolicies(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("policies"); !ok || v.(string) == "" || !diff.NewValueKnown("tier") {
+		return nil
+	}
+
+	if tier := diff.Get("tier").(string); tier == ssm.ParameterTierStandard {
+		return fmt.Errorf("policies can only be set for parameters in the %s or %s tier, not %s", ssm.ParameterTierAdvanced, ssm.ParameterTierIntelligentTiering, tier)
+	}
+
+	return nil
+}
+
+// validParameterValueAllowedPattern returns an error if the value does not match the allowed pattern.
+// Patterns that aren't valid Go regular expressions are left for SSM to evaluate.
+// The value itself is not included in the error as it may be a secret.
+func validParameterValueAllowedPattern(value, pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+
+	re, err := regexp.Compile(pattern)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to validate SSM Parameter value against allowed_pattern (%s): %s", pattern, err)
+		return nil
+	}
+
+	if !re.MatchString(value) {
+		return fmt.Errorf("value does not match allowed_pattern (%s)", pattern)
+	}
+
+	return nil
+}
+
 func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
@@ -172,6 +276,10 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("policies"); ok {
+		input.Policies = aws.String(v.(string))
+	}
+
 	if keyID, ok := d.GetOk("key_id"); ok && d.Get("type").(string) == ssm.ParameterTypeSecureString {
 		input.SetKeyId(keyID.(string))
 	}
@@ -287,12 +395,27 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	detail := describeResp.Parameters[0]
-	d.Set("key_id", detail.KeyId)
+	keyID := aws.StringValue(detail.KeyId)
+	// Keep the key identifier in its existing form, e.g. an alias, while it refers to the same key.
+	if old := d.Get("key_id").(string); old != keyID && old != "" && keyID != "" {
+		if same, err := sameKMSKey(ctx, old, keyID, findKMSKeyARNFunc(meta.(*conns.AWSClient).KMSConn())); err != nil {
+			log.Printf("[WARN] Unable to compare SSM Parameter (%s) KMS keys %q and %q: %s", d.Id(), old, keyID, err)
+		} else if same {
+			keyID = old
+		}
+	}
+	d.Set("key_id", keyID)
 	d.Set("description", detail.Description)
 	d.Set("tier", detail.Tier)
 	d.Set("allowed_pattern", detail.AllowedPattern)
 	d.Set("data_type", detail.DataType)
 
+	policies, err := flattenParameterInlinePolicies(detail.Policies)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter (%s): %s", d.Id(), err)
+	}
+	d.Set("policies", policies)
+
 	return diags
 }
 
@@ -333,6 +456,14 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			paramInput.SetKeyId(d.Get("key_id").(string))
 		}
 
+		// Policies are sent with every update so that they are retained alongside the new value.
+		if v := d.Get("policies").(string); v != "" {
+			paramInput.Policies = aws.String(v)
+		} else if d.HasChange("policies") {
+			// An empty list removes all existing policies.
+			paramInput.Policies = aws.String("[]")
+		}
+
 		_, err := conn.PutParameterWithContext(ctx, paramInput)
 
 		if tfawserr.ErrMessageContains(err, "ValidationException", "Tier is not supported") {
@@ -368,6 +499,31 @@ func resourceParameterDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// flattenParameterInlinePolicies returns the policies of a parameter as a JSON array, in the form accepted by PutParameter.
+func flattenParameterInlinePolicies(apiObjects []*ssm.ParameterInlinePolicy) (string, error) {
+	if len(apiObjects) == 0 {
+		return "", nil
+	}
+
+	var tfList []json.RawMessage
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, json.RawMessage(aws.StringValue(apiObject.PolicyText)))
+	}
+
+	v, err := json.Marshal(tfList)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(v))
+}
+
 func ShouldUpdateParameter(d *schema.ResourceData) bool {
 	// If the user has specified a preference, return their preference
 	if value, ok := d.GetOkExists("overwrite"); ok {
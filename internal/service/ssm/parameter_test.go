@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -95,6 +96,96 @@ func TestAccSSMParameter_tier(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_policies(t *testing.T) {
+	ctx := acctest.Context(t)
+	var parameter1, parameter2 ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+	expiration := time.Now().UTC().Add(24 * time.Hour).Format("2006-01-02T15:04:05.000Z")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_policies(rName, expiration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter1),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexp.MustCompile(`"Type":"Expiration"`)),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexp.MustCompile(`"Type":"NoChangeNotification"`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+			{
+				Config: testAccParameterConfig_tier(rName, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter2),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameter_Policies_standardTier(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	expiration := time.Now().UTC().Add(24 * time.Hour).Format("2006-01-02T15:04:05.000Z")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_policiesTier(rName, ssm.ParameterTierStandard, expiration),
+				ExpectError: regexp.MustCompile(`policies can only be set for parameters in the Advanced or Intelligent-Tiering tier`),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameter_AllowedPattern_violation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var parameter ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_allowedPattern(rName, "abc"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value does not match allowed_pattern`),
+			},
+			{
+				Config: testAccParameterConfig_allowedPattern(rName, "123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "allowed_pattern", `^\d+$`),
+				),
+			},
+			{
+				Config:      testAccParameterConfig_allowedPattern(rName, "12a"),
+				ExpectError: regexp.MustCompile(`value does not match allowed_pattern`),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_Tier_intelligentTieringToStandard(t *testing.T) {
 	ctx := acctest.Context(t)
 	var parameter ssm.Parameter
@@ -889,6 +980,44 @@ func TestAccSSMParameter_Secure_keyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_Secure_keyAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param ssm.Parameter
+	randString := sdkacctest.RandString(10)
+	name := fmt.Sprintf("%s_%s", t.Name(), randString)
+	resourceName := "aws_ssm_parameter.test"
+	keyResourceName := "aws_kms_key.test_key"
+	otherKeyResourceName := "aws_kms_key.test_key2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_secureKeyID(name, "secret", randString, keyResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "arn"),
+				),
+			},
+			{
+				// The alias refers to the same key as the ARN.
+				Config:   testAccParameterConfig_secureKeyID(name, "secret", randString, "aws_kms_alias.test_alias.name"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccParameterConfig_secureKeyID(name, "secret", randString, otherKeyResourceName+".arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", otherKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckParameterRecreated(t *testing.T,
 	before, after *ssm.Parameter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1001,6 +1130,50 @@ resource "aws_ssm_parameter" "test" {
 `, rName, tier)
 }
 
+func testAccParameterConfig_policies(rName, expiration string) string {
+	return testAccParameterConfig_policiesTier(rName, ssm.ParameterTierAdvanced, expiration)
+}
+
+func testAccParameterConfig_policiesTier(rName, tier, expiration string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([
+    {
+      Type    = "Expiration"
+      Version = "1.0"
+      Attributes = {
+        Timestamp = %[3]q
+      }
+    },
+    {
+      Type    = "NoChangeNotification"
+      Version = "1.0"
+      Attributes = {
+        After = "20"
+        Unit  = "Days"
+      }
+    },
+  ])
+}
+`, rName, tier, expiration)
+}
+
+func testAccParameterConfig_allowedPattern(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name            = %[1]q
+  type            = "String"
+  value           = %[2]q
+  allowed_pattern = "^\\d+$"
+}
+`, rName, value)
+}
+
 func testAccParameterConfig_tierWithValue(rName, tier, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
@@ -1155,6 +1328,86 @@ resource "aws_kms_alias" "test_alias" {
 `, rName, value, keyAlias)
 }
 
+// testAccParameterConfig_secureKeyID configures the parameter's key_id with the specified expression.
+func testAccParameterConfig_secureKeyID(rName, value, keyAlias, keyID string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name        = "test_secure_parameter-%[1]s"
+  description = "description for parameter %[1]s"
+  type        = "SecureString"
+  value       = "%[2]s"
+  key_id      = %[4]s
+}
+
+resource "aws_kms_key" "test_key" {
+  description             = "KMS key 1"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "test_key2" {
+  description             = "KMS key 2"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "test_alias" {
+  name          = "alias/%[3]s"
+  target_key_id = aws_kms_key.test_key.id
+}
+`, rName, value, keyAlias, keyID)
+}
+
+func TestValidParameterValueAllowedPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		value         string
+		pattern       string
+		expectedError *regexp.Regexp
+	}{
+		{
+			name:  "no pattern",
+			value: "abc",
+		},
+		{
+			name:    "match",
+			value:   "123",
+			pattern: `^\d+$`,
+		},
+		{
+			name:          "no match",
+			value:         "12a",
+			pattern:       `^\d+$`,
+			expectedError: regexp.MustCompile(`value does not match allowed_pattern \(\^\\d\+\$\)`),
+		},
+		{
+			name:    "unsupported pattern",
+			value:   "abc",
+			pattern: `^(?!xyz).*$`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfssm.ValidParameterValueAllowedPattern(testCase.value, testCase.pattern)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
 func TestParameterShouldUpdate(t *testing.T) {
 	t.Parallel()
 
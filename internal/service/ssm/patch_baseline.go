@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,7 +34,12 @@ func ResourcePatchBaseline() *schema.Resource {
 		DeleteWithoutTimeout: resourcePatchBaselineDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// force_delete isn't returned by the API.
+				d.Set("force_delete", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,6 +62,12 @@ func ResourcePatchBaseline() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
 
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"global_filter": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -339,7 +351,7 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("force_delete", "tags", "tags_all") {
 		input := &ssm.UpdatePatchBaselineInput{
 			BaselineId: aws.String(d.Id()),
 		}
@@ -397,21 +409,60 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 func resourcePatchBaselineDelete(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).SSMConn()
 
-	log.Printf("[INFO] Deleting SSM Patch Baseline: %s", d.Id())
+	patchGroups, err := findPatchGroupsByBaselineID(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Baseline (%s) patch groups: %s", d.Id(), err)
+	}
 
-	params := &ssm.DeletePatchBaselineInput{
-		BaselineId: aws.String(d.Id()),
+	if len(patchGroups) > 0 {
+		if !d.Get("force_delete").(bool) {
+			return sdkdiag.AppendErrorf(diags, "deleting SSM Patch Baseline (%s): still registered for patch groups (%s). Deregister them or set force_delete to true", d.Id(), strings.Join(patchGroups, ", "))
+		}
+
+		for _, patchGroup := range patchGroups {
+			log.Printf("[INFO] Deregistering SSM Patch Baseline (%s) for Patch Group (%s)", d.Id(), patchGroup)
+			_, err := conn.DeregisterPatchBaselineForPatchGroupWithContext(ctx, &ssm.DeregisterPatchBaselineForPatchGroupInput{
+				BaselineId: aws.String(d.Id()),
+				PatchGroup: aws.String(patchGroup),
+			})
+
+			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDoesNotExistException) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deregistering SSM Patch Baseline (%s) for Patch Group (%s): %s", d.Id(), patchGroup, err)
+			}
+		}
 	}
 
-	_, err := conn.DeletePatchBaselineWithContext(ctx, params)
-	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeResourceInUseException) {
-		// Reset the default patch baseline before retrying
-		diags = append(diags, defaultPatchBaselineRestoreOSDefault(ctx, meta.(ssmClient), types.OperatingSystem(d.Get("operating_system").(string)))...)
-		if diags.HasError() {
-			return
+	// Reset the default patch baseline for the operating system if it's this one.
+	os := types.OperatingSystem(d.Get("operating_system").(string))
+	defaultPatchBaseline, err := FindDefaultPatchBaseline(ctx, meta.(ssmClient).SSMClient(), os)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading SSM Default Patch Baseline for operating system %q: %s", os, err)
+	default:
+		baselineID := aws.StringValue(defaultPatchBaseline.BaselineId)
+		if isPatchBaselineARN(baselineID) {
+			baselineID = patchBaselineIDFromARN(baselineID)
+		}
+
+		if baselineID == d.Id() {
+			diags = append(diags, defaultPatchBaselineRestoreOSDefault(ctx, meta.(ssmClient), os)...)
+			if diags.HasError() {
+				return
+			}
 		}
-		_, err = conn.DeletePatchBaselineWithContext(ctx, params)
 	}
+
+	log.Printf("[INFO] Deleting SSM Patch Baseline: %s", d.Id())
+	_, err = conn.DeletePatchBaselineWithContext(ctx, &ssm.DeletePatchBaselineInput{
+		BaselineId: aws.String(d.Id()),
+	})
+
 	if err != nil {
 		diags = sdkdiag.AppendErrorf(diags, "deleting SSM Patch Baseline (%s): %s", d.Id(), err)
 	}
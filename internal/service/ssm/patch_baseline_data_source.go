@@ -113,8 +113,15 @@ func DataSourcePatchBaseline() *schema.Resource {
 			},
 			"owner": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 255),
+				ExactlyOneOf: []string{"owner", "patch_group"},
+			},
+			"patch_group": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 256),
+				ConflictsWith: []string{"default_baseline", "name_prefix"},
 			},
 			"rejected_patches": {
 				Type:     schema.TypeList,
@@ -154,66 +161,101 @@ func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
 
-	filters := []*ssm.PatchOrchestratorFilter{
-		{
-			Key: aws.String("OWNER"),
-			Values: []*string{
-				aws.String(d.Get("owner").(string)),
-			},
-		},
-	}
+	var baselineID string
+	var defaultBaseline bool
 
-	if v, ok := d.GetOk("name_prefix"); ok {
-		filters = append(filters, &ssm.PatchOrchestratorFilter{
-			Key: aws.String("NAME_PREFIX"),
-			Values: []*string{
-				aws.String(v.(string)),
-			},
+	if v, ok := d.GetOk("patch_group"); ok {
+		patchGroup := v.(string)
+		output, err := findPatchBaselineForPatchGroup(ctx, conn, patchGroup, d.Get("operating_system").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Patch Baseline for Patch Group (%s): %s", patchGroup, err)
+		}
+
+		baselineID = aws.StringValue(output.BaselineId)
+
+		defaultOutput, err := conn.GetDefaultPatchBaselineWithContext(ctx, &ssm.GetDefaultPatchBaselineInput{
+			OperatingSystem: output.OperatingSystem,
 		})
-	}
 
-	params := &ssm.DescribePatchBaselinesInput{
-		Filters: filters,
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Default Patch Baseline (%s): %s", aws.StringValue(output.OperatingSystem), err)
+		}
 
-	log.Printf("[DEBUG] Reading DescribePatchBaselines: %s", params)
+		// The IDs of patch baselines provided by AWS are returned as ARNs.
+		id, defaultID := baselineID, aws.StringValue(defaultOutput.BaselineId)
+		if isPatchBaselineARN(id) {
+			id = patchBaselineIDFromARN(id)
+		}
+		if isPatchBaselineARN(defaultID) {
+			defaultID = patchBaselineIDFromARN(defaultID)
+		}
 
-	resp, err := conn.DescribePatchBaselinesWithContext(ctx, params)
+		defaultBaseline = id == defaultID
+	} else {
+		filters := []*ssm.PatchOrchestratorFilter{
+			{
+				Key: aws.String("OWNER"),
+				Values: []*string{
+					aws.String(d.Get("owner").(string)),
+				},
+			},
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "describing SSM PatchBaselines: %s", err)
-	}
+		if v, ok := d.GetOk("name_prefix"); ok {
+			filters = append(filters, &ssm.PatchOrchestratorFilter{
+				Key: aws.String("NAME_PREFIX"),
+				Values: []*string{
+					aws.String(v.(string)),
+				},
+			})
+		}
 
-	var filteredBaselines []*ssm.PatchBaselineIdentity
-	if v, ok := d.GetOk("operating_system"); ok {
-		for _, baseline := range resp.BaselineIdentities {
-			if v.(string) == aws.StringValue(baseline.OperatingSystem) {
-				filteredBaselines = append(filteredBaselines, baseline)
+		params := &ssm.DescribePatchBaselinesInput{
+			Filters: filters,
+		}
+
+		log.Printf("[DEBUG] Reading DescribePatchBaselines: %s", params)
+
+		resp, err := conn.DescribePatchBaselinesWithContext(ctx, params)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "describing SSM PatchBaselines: %s", err)
+		}
+
+		var filteredBaselines []*ssm.PatchBaselineIdentity
+		if v, ok := d.GetOk("operating_system"); ok {
+			for _, baseline := range resp.BaselineIdentities {
+				if v.(string) == aws.StringValue(baseline.OperatingSystem) {
+					filteredBaselines = append(filteredBaselines, baseline)
+				}
 			}
 		}
-	}
 
-	if v, ok := d.GetOk("default_baseline"); ok {
-		for _, baseline := range filteredBaselines {
-			if v.(bool) == aws.BoolValue(baseline.DefaultBaseline) {
-				filteredBaselines = []*ssm.PatchBaselineIdentity{baseline}
-				break
+		if v, ok := d.GetOk("default_baseline"); ok {
+			for _, baseline := range filteredBaselines {
+				if v.(bool) == aws.BoolValue(baseline.DefaultBaseline) {
+					filteredBaselines = []*ssm.PatchBaselineIdentity{baseline}
+					break
+				}
 			}
 		}
-	}
 
-	if len(filteredBaselines) < 1 || filteredBaselines[0] == nil {
-		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
-	}
+		if len(filteredBaselines) < 1 || filteredBaselines[0] == nil {
+			return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
+		}
 
-	if len(filteredBaselines) > 1 {
-		return sdkdiag.AppendErrorf(diags, "Your query returned more than one result. Please try a more specific search criteria")
-	}
+		if len(filteredBaselines) > 1 {
+			return sdkdiag.AppendErrorf(diags, "Your query returned more than one result. Please try a more specific search criteria")
+		}
 
-	baseline := filteredBaselines[0]
+		baseline := filteredBaselines[0]
+		baselineID = aws.StringValue(baseline.BaselineId)
+		defaultBaseline = aws.BoolValue(baseline.DefaultBaseline)
+	}
 
 	input := &ssm.GetPatchBaselineInput{
-		BaselineId: baseline.BaselineId,
+		BaselineId: aws.String(baselineID),
 	}
 
 	output, err := conn.GetPatchBaselineWithContext(ctx, input)
@@ -222,16 +264,16 @@ func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "getting SSM PatchBaseline: %s", err)
 	}
 
-	d.SetId(aws.StringValue(baseline.BaselineId))
+	d.SetId(baselineID)
 	d.Set("approved_patches", aws.StringValueSlice(output.ApprovedPatches))
 	d.Set("approved_patches_compliance_level", output.ApprovedPatchesComplianceLevel)
 	d.Set("approved_patches_enable_non_security", output.ApprovedPatchesEnableNonSecurity)
 	d.Set("approval_rule", flattenPatchRuleGroup(output.ApprovalRules))
-	d.Set("default_baseline", baseline.DefaultBaseline)
-	d.Set("description", baseline.BaselineDescription)
+	d.Set("default_baseline", defaultBaseline)
+	d.Set("description", output.Description)
 	d.Set("global_filter", flattenPatchFilterGroup(output.GlobalFilters))
-	d.Set("name", baseline.BaselineName)
-	d.Set("operating_system", baseline.OperatingSystem)
+	d.Set("name", output.Name)
+	d.Set("operating_system", output.OperatingSystem)
 	d.Set("rejected_patches", aws.StringValueSlice(output.RejectedPatches))
 	d.Set("rejected_patches_action", output.RejectedPatchesAction)
 	d.Set("source", flattenPatchSource(output.Sources))
@@ -71,6 +71,37 @@ func TestAccSSMPatchBaselineDataSource_newBaseline(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaselineDataSource_patchGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baseline.test"
+	unregisteredDataSourceName := "data.aws_ssm_patch_baseline.unregistered"
+	resourceName := "aws_ssm_patch_baseline.test"
+	rName := sdkacctest.RandomWithPrefix("tf-bl-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselineDataSourceConfig_patchGroup(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "approval_rule", resourceName, "approval_rule"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_baseline", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "operating_system", resourceName, "operating_system"),
+					resource.TestCheckResourceAttr(unregisteredDataSourceName, "default_baseline", "true"),
+					resource.TestCheckResourceAttr(unregisteredDataSourceName, "name", "AWS-AmazonLinux2DefaultPatchBaseline"),
+					resource.TestCheckResourceAttr(unregisteredDataSourceName, "operating_system", "AMAZON_LINUX_2"),
+				),
+			},
+		},
+	})
+}
+
 // Test against one of the default baselines created by AWS
 func testAccPatchBaselineDataSourceConfig_existing() string {
 	return `
@@ -106,3 +137,37 @@ data "aws_ssm_patch_baseline" "test" {
 }
 `, name)
 }
+
+// Register a new baseline to a patch group and resolve the baseline in effect for it
+func testAccPatchBaselineDataSourceConfig_patchGroup(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "AMAZON_LINUX_2"
+  description      = "Test"
+
+  approval_rule {
+    approve_after_days = 5
+    patch_filter {
+      key    = "CLASSIFICATION"
+      values = ["*"]
+    }
+  }
+}
+
+resource "aws_ssm_patch_group" "test" {
+  baseline_id = aws_ssm_patch_baseline.test.id
+  patch_group = %[1]q
+}
+
+data "aws_ssm_patch_baseline" "test" {
+  patch_group      = aws_ssm_patch_group.test.patch_group
+  operating_system = "AMAZON_LINUX_2"
+}
+
+data "aws_ssm_patch_baseline" "unregistered" {
+  patch_group      = "%[1]s-unregistered"
+  operating_system = "AMAZON_LINUX_2"
+}
+`, name)
+}
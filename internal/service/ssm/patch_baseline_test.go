@@ -342,6 +342,55 @@ func TestAccSSMPatchBaseline_rejectPatchesAction(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaseline_deleteRegisteredPatchGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ssmPatch ssm.PatchBaselineIdentity
+	name := sdkacctest.RandString(10)
+	patchGroup := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_patch_baseline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselineConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &ssmPatch),
+					resource.TestCheckResourceAttr(resourceName, "force_delete", "false"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+					input := &ssm.RegisterPatchBaselineForPatchGroupInput{
+						BaselineId: ssmPatch.BaselineId,
+						PatchGroup: aws.String(patchGroup),
+					}
+					if _, err := conn.RegisterPatchBaselineForPatchGroupWithContext(ctx, input); err != nil {
+						t.Fatalf("registering SSM Patch Baseline (%s) for Patch Group (%s): %s", aws.StringValue(ssmPatch.BaselineId), patchGroup, err)
+					}
+				},
+				Config:      "# Empty config", // Attempts to delete the patch baseline
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`still registered for patch groups \(%s\)`, patchGroup)),
+			},
+			{
+				Config: testAccPatchBaselineConfig_forceDelete(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &ssmPatch),
+					resource.TestCheckResourceAttr(resourceName, "force_delete", "true"),
+				),
+			},
+			{
+				Config: "# Empty config", // Deregisters the patch group and deletes the patch baseline
+			},
+		},
+	})
+}
+
 // testAccSSMPatchBaseline_deleteDefault needs to be serialized with the other
 // Default Patch Baseline acceptance tests because it sets the default patch baseline
 func testAccSSMPatchBaseline_deleteDefault(t *testing.T) {
@@ -511,6 +560,18 @@ resource "aws_ssm_patch_baseline" "test" {
 `, rName)
 }
 
+func testAccPatchBaselineConfig_forceDelete(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name                              = "patch-baseline-%s"
+  description                       = "Baseline containing all updates approved for production systems"
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+  force_delete                      = true
+}
+`, rName)
+}
+
 func testAccPatchBaselineConfig_operatingSystem(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_patch_baseline" "test" {
@@ -45,6 +45,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
 		},
+		{
+			Factory:  DataSourceServiceSetting,
+			TypeName: "aws_ssm_service_setting",
+		},
 	}
 }
 
@@ -0,0 +1,71 @@
+package ssm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ssm_service_setting")
+func DataSourceServiceSetting() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceSettingRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_user": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"setting_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"setting_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	settingID := d.Get("setting_id").(string)
+	output, err := FindServiceSettingByID(ctx, conn, settingID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Service Setting (%s): %s", settingID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ARN))
+	d.Set("arn", output.ARN)
+	if output.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_date", nil)
+	}
+	d.Set("last_modified_user", output.LastModifiedUser)
+	d.Set("setting_value", output.SettingValue)
+	d.Set("status", output.Status)
+
+	return diags
+}
@@ -0,0 +1,39 @@
+package ssm_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMServiceSettingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_service_setting.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSettingDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrRegionalARN(dataSourceName, "arn", "ssm", "servicesetting/ssm/managed-instance/activation-tier"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_modified_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_modified_user"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "setting_value"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Default"),
+				),
+			},
+		},
+	})
+}
+
+// Unlike parameter-store/high-throughput-enabled, used by the resource tests, the activation tier is not modified by any test.
+const testAccServiceSettingDataSourceConfig_basic = `
+data "aws_ssm_service_setting" "test" {
+  setting_id = "/ssm/managed-instance/activation-tier"
+}
+`
@@ -268,6 +268,31 @@ func ValidIPv6CIDRNetworkAddress(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+// ValidIPv46CIDRNetworkAddress ensures that the string value is a valid IPv4 or IPv6 CIDR that
+// represents a network address - it adds an error otherwise
+func ValidIPv46CIDRNetworkAddress(v interface{}, k string) (ws []string, errors []error) {
+	cidr := v.(string)
+
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid IPv4 or IPv6 CIDR block: %w", cidr, err))
+		return
+	}
+
+	if ip.To4() != nil {
+		err = ValidateIPv4CIDRBlock(cidr)
+	} else {
+		err = ValidateIPv6CIDRBlock(cidr)
+	}
+
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return
+}
+
 // IsIPv4CIDRBlockOrIPv6CIDRBlock returns a SchemaValidateFunc that test if the provided value:
 // - Is a valid IPv4 CIDR block and passes the specified validation, or
 // - Is a valid IPv6 CIDR block and passes the specified validation
@@ -280,6 +280,42 @@ func TestValidCIDRNetworkAddress(t *testing.T) {
 	}
 }
 
+func TestValidIPv46CIDRNetworkAddress(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		CIDR              string
+		ExpectedErrSubstr string
+	}{
+		{"notacidr", `is not a valid IPv4 or IPv6 CIDR block`},
+		{"10.0.0.1", `is not a valid IPv4 or IPv6 CIDR block`},
+		{"10.0.1.0/16", `is not a valid IPv4 CIDR block; did you mean`},
+		{"10.0.1.0/24", ``},
+		{"10.0.0.1/32", ``},
+		{"2001:db8::/122", ``},
+		{"2001::/15", `is not a valid IPv6 CIDR block; did you mean`},
+	}
+
+	for i, tc := range cases {
+		_, errs := ValidIPv46CIDRNetworkAddress(tc.CIDR, "foo")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errs) != 0 {
+				t.Fatalf("%d/%d: Expected no error, got errs: %#v",
+					i+1, len(cases), errs)
+			}
+		} else {
+			if len(errs) != 1 {
+				t.Fatalf("%d/%d: Expected 1 err containing %q, got %d errs",
+					i+1, len(cases), tc.ExpectedErrSubstr, len(errs))
+			}
+			if !strings.Contains(errs[0].Error(), tc.ExpectedErrSubstr) {
+				t.Fatalf("%d/%d: Expected err: %q, to include %q",
+					i+1, len(cases), errs[0], tc.ExpectedErrSubstr)
+			}
+		}
+	}
+}
+
 func TestValidIPv4CIDRBlock(t *testing.T) {
 	t.Parallel()
 